@@ -0,0 +1,25 @@
+package concurrency
+
+// AggregateRuns merges the results of several CheckWebsites runs into a map of
+// urls to the fraction of runs in which that url was up. A url missing from a
+// run is counted as down for that run.
+func AggregateRuns(runs []map[string]bool) map[string]float64 {
+	upCounts := make(map[string]int)
+
+	for _, run := range runs {
+		for url, up := range run {
+			if up {
+				upCounts[url]++
+			} else if _, seen := upCounts[url]; !seen {
+				upCounts[url] = 0
+			}
+		}
+	}
+
+	scores := make(map[string]float64)
+	for url, count := range upCounts {
+		scores[url] = float64(count) / float64(len(runs))
+	}
+
+	return scores
+}
@@ -0,0 +1,39 @@
+package concurrency
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAggregateRuns(t *testing.T) {
+	runs := []map[string]bool{
+		{
+			"http://google.com":          true,
+			"http://blog.gypsydave5.com": true,
+			"waat://furhurterwe.geds":    false,
+		},
+		{
+			"http://google.com":          true,
+			"http://blog.gypsydave5.com": false,
+			"waat://furhurterwe.geds":    false,
+		},
+		{
+			"http://google.com":       true,
+			"waat://furhurterwe.geds": false,
+			"http://quii.co.uk":       true,
+		},
+	}
+
+	want := map[string]float64{
+		"http://google.com":          1,
+		"http://blog.gypsydave5.com": 1.0 / 3,
+		"waat://furhurterwe.geds":    0,
+		"http://quii.co.uk":          1.0 / 3,
+	}
+
+	got := AggregateRuns(runs)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Fatalf("Wanted %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,51 @@
+package concurrency
+
+import "sort"
+
+// Change is a url whose result is different from the last time it was checked
+type Change struct {
+	URL      string
+	From, To bool
+}
+
+// ChangeTracker remembers the last result for each url so only changes are reported
+type ChangeTracker struct {
+	previous map[string]bool
+}
+
+// NewChangeTracker creates a ChangeTracker with no previous results, so every
+// url is reported as a change the first time it is seen
+func NewChangeTracker() *ChangeTracker {
+	return NewChangeTrackerWithBaseline(nil)
+}
+
+// NewChangeTrackerWithBaseline creates a ChangeTracker which treats baseline as
+// the previous results, so the first run only reports urls that differ from it
+func NewChangeTrackerWithBaseline(baseline map[string]bool) *ChangeTracker {
+	previous := make(map[string]bool)
+	for url, up := range baseline {
+		previous[url] = up
+	}
+	return &ChangeTracker{previous}
+}
+
+// Update takes the results of a CheckWebsites run and returns, sorted by url,
+// those whose result has changed since the previous run. A url which has not
+// been seen before is always a change, with From set to false
+func (c *ChangeTracker) Update(results map[string]bool) []Change {
+	var changes []Change
+
+	for url, up := range results {
+		was, seen := c.previous[url]
+		if !seen || was != up {
+			changes = append(changes, Change{url, was, up})
+		}
+		c.previous[url] = up
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].URL < changes[j].URL
+	})
+
+	return changes
+}
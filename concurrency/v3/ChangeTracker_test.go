@@ -0,0 +1,61 @@
+package concurrency
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChangeTracker(t *testing.T) {
+	firstRun := map[string]bool{
+		"http://google.com":          true,
+		"http://blog.gypsydave5.com": true,
+		"waat://furhurterwe.geds":    false,
+	}
+
+	secondRun := map[string]bool{
+		"http://google.com":          true,
+		"http://blog.gypsydave5.com": false,
+		"waat://furhurterwe.geds":    true,
+	}
+
+	t.Run("reports everything on the first run then only what flipped", func(t *testing.T) {
+		tracker := NewChangeTracker()
+
+		assertChanges(t, tracker.Update(firstRun), []Change{
+			{"http://blog.gypsydave5.com", false, true},
+			{"http://google.com", false, true},
+			{"waat://furhurterwe.geds", false, false},
+		})
+
+		assertChanges(t, tracker.Update(secondRun), []Change{
+			{"http://blog.gypsydave5.com", true, false},
+			{"waat://furhurterwe.geds", false, true},
+		})
+
+		assertChanges(t, tracker.Update(secondRun), nil)
+	})
+
+	t.Run("a baseline hides urls that match it on the first run", func(t *testing.T) {
+		tracker := NewChangeTrackerWithBaseline(map[string]bool{
+			"http://google.com":          true,
+			"http://blog.gypsydave5.com": true,
+			"waat://furhurterwe.geds":    true,
+		})
+
+		assertChanges(t, tracker.Update(firstRun), []Change{
+			{"waat://furhurterwe.geds", true, false},
+		})
+
+		assertChanges(t, tracker.Update(secondRun), []Change{
+			{"http://blog.gypsydave5.com", true, false},
+			{"waat://furhurterwe.geds", false, true},
+		})
+	})
+}
+
+func assertChanges(t *testing.T, got, want []Change) {
+	t.Helper()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
@@ -0,0 +1,49 @@
+package concurrency
+
+import "runtime"
+
+// autoWorkersPerProc is how many workers CheckAuto runs per usable CPU.
+// Checking a website is mostly waiting on the network rather than using the
+// CPU, so each CPU can keep many checks in flight at once
+const autoWorkersPerProc = 16
+
+// CheckAuto checks urls like CheckWebsites, but with a fixed pool of workers
+// rather than a goroutine per url. The pool is sized to
+// runtime.GOMAXPROCS(0)*autoWorkersPerProc, so it grows with the CPUs Go is
+// allowed to use while still bounding the number of open connections when
+// checking a very long list of urls
+func CheckAuto(wc WebsiteChecker, urls []string) map[string]bool {
+	return checkWithWorkers(wc, urls, runtime.GOMAXPROCS(0)*autoWorkersPerProc)
+}
+
+func checkWithWorkers(wc WebsiteChecker, urls []string, workers int) map[string]bool {
+	if workers > len(urls) {
+		workers = len(urls)
+	}
+
+	jobs := make(chan string)
+	resultChannel := make(chan result, len(urls))
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for u := range jobs {
+				resultChannel <- result{u, safeCheck(wc, u)}
+			}
+		}()
+	}
+
+	go func() {
+		for _, url := range urls {
+			jobs <- url
+		}
+		close(jobs)
+	}()
+
+	results := make(map[string]bool)
+	for i := 0; i < len(urls); i++ {
+		result := <-resultChannel
+		results[result.string] = result.bool
+	}
+
+	return results
+}
@@ -0,0 +1,40 @@
+package concurrency
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckAuto(t *testing.T) {
+	websites := []string{
+		"http://google.com",
+		"http://blog.gypsydave5.com",
+		"waat://furhurterwe.geds",
+	}
+
+	want := CheckWebsites(mockWebsiteChecker, websites)
+
+	t.Run("gives the same results as CheckWebsites", func(t *testing.T) {
+		got := CheckAuto(mockWebsiteChecker, websites)
+
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("works with fewer workers than urls", func(t *testing.T) {
+		got := checkWithWorkers(mockWebsiteChecker, websites, 1)
+
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("got %v want %v", got, want)
+		}
+	})
+
+	t.Run("no urls", func(t *testing.T) {
+		got := CheckAuto(mockWebsiteChecker, nil)
+
+		if len(got) != 0 {
+			t.Fatalf("got %v want no results", got)
+		}
+	})
+}
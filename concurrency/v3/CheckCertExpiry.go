@@ -0,0 +1,117 @@
+package concurrency
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+)
+
+const tlsDialTimeout = 10 * time.Second
+
+// CertExpiry is the result of checking a url's certificate
+type CertExpiry struct {
+	NotAfter time.Time
+	Err      error
+}
+
+// CheckCertExpiry connects to an https url and returns when its leaf certificate
+// expires. The certificate must still be trusted and match the url's host, but
+// one which has already expired is not an error, its expiry is just in the past
+func CheckCertExpiry(url string) (time.Time, error) {
+	return checkCertExpiry(url, nil)
+}
+
+// CheckCertExpiries checks the certificate of each url concurrently, returning a
+// map of urls to their expiry or the error found trying to get it
+func CheckCertExpiries(urls []string) map[string]CertExpiry {
+	return checkCertExpiries(urls, nil)
+}
+
+type certExpiryResult struct {
+	url    string
+	expiry CertExpiry
+}
+
+func checkCertExpiries(urls []string, config *tls.Config) map[string]CertExpiry {
+	results := make(map[string]CertExpiry)
+	resultChannel := make(chan certExpiryResult, len(urls))
+
+	for _, u := range urls {
+		go func(u string) {
+			notAfter, err := checkCertExpiry(u, config)
+			resultChannel <- certExpiryResult{u, CertExpiry{notAfter, err}}
+		}(u)
+	}
+
+	for i := 0; i < len(urls); i++ {
+		result := <-resultChannel
+		results[result.url] = result.expiry
+	}
+
+	return results
+}
+
+func checkCertExpiry(rawURL string, config *tls.Config) (time.Time, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("problem parsing %q, %v", rawURL, err)
+	}
+
+	if u.Scheme != "https" {
+		return time.Time{}, fmt.Errorf("cannot check certificate of %q, it is not an https url", rawURL)
+	}
+
+	address := u.Host
+	if u.Port() == "" {
+		address = net.JoinHostPort(u.Hostname(), "443")
+	}
+
+	// expired certificates would fail the handshake, so verify the certificate ourselves without checking its dates
+	dialConfig := &tls.Config{}
+	if config != nil {
+		dialConfig = config.Clone()
+	}
+	dialConfig.InsecureSkipVerify = true
+
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: tlsDialTimeout}, "tcp", address, dialConfig)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("problem connecting to %q, %v", rawURL, err)
+	}
+	defer conn.Close()
+
+	certificates := conn.ConnectionState().PeerCertificates
+	if len(certificates) == 0 {
+		return time.Time{}, fmt.Errorf("%q did not present a certificate", rawURL)
+	}
+
+	if err := verifyIgnoringExpiry(certificates, u.Hostname(), config); err != nil {
+		return time.Time{}, fmt.Errorf("problem verifying certificate of %q, %v", rawURL, err)
+	}
+
+	return certificates[0].NotAfter, nil
+}
+
+// verifyIgnoringExpiry checks the leaf certificate is for host and chains up to
+// a trusted root, as of the moment it expires rather than now
+func verifyIgnoringExpiry(certificates []*x509.Certificate, host string, config *tls.Config) error {
+	leaf := certificates[0]
+
+	options := x509.VerifyOptions{
+		DNSName:       host,
+		Intermediates: x509.NewCertPool(),
+		CurrentTime:   leaf.NotAfter,
+	}
+	if config != nil {
+		options.Roots = config.RootCAs
+	}
+
+	for _, intermediate := range certificates[1:] {
+		options.Intermediates.AddCert(intermediate)
+	}
+
+	_, err := leaf.Verify(options)
+	return err
+}
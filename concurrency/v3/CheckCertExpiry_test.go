@@ -0,0 +1,127 @@
+package concurrency
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCheckCertExpiry(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	// we hang up straight after the handshake, which the server would otherwise log about
+	server.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+
+	// the test server's certificate is self signed, so trust it explicitly
+	config := &tls.Config{RootCAs: server.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs}
+
+	t.Run("returns the expiry of the certificate", func(t *testing.T) {
+		got, err := checkCertExpiry(server.URL, config)
+
+		if err != nil {
+			t.Fatalf("did not expect an error but got %v", err)
+		}
+
+		if want := server.Certificate().NotAfter; !got.Equal(want) {
+			t.Errorf("got expiry %v want %v", got, want)
+		}
+	})
+
+	t.Run("errors for certificates which are not trusted", func(t *testing.T) {
+		_, err := checkCertExpiry(server.URL, &tls.Config{RootCAs: x509.NewCertPool()})
+
+		if err == nil {
+			t.Error("expected an error checking an untrusted certificate")
+		}
+	})
+
+	t.Run("errors for non https urls", func(t *testing.T) {
+		_, err := CheckCertExpiry("http://google.com")
+
+		if err == nil {
+			t.Error("expected an error checking a http url")
+		}
+	})
+
+	t.Run("checks a batch of urls", func(t *testing.T) {
+		got := checkCertExpiries([]string{server.URL, "http://google.com"}, config)
+
+		if got[server.URL].Err != nil || got[server.URL].NotAfter.IsZero() {
+			t.Errorf("expected an expiry for %s but got %+v", server.URL, got[server.URL])
+		}
+
+		if got["http://google.com"].Err == nil {
+			t.Error("expected an error for http://google.com")
+		}
+	})
+}
+
+func TestCheckCertExpiryExpired(t *testing.T) {
+	expiredAt := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	certificate := newSelfSignedCertificate(t, expiredAt.Add(-24*time.Hour), expiredAt)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Config.ErrorLog = log.New(ioutil.Discard, "", 0)
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{certificate}}
+	server.StartTLS()
+	defer server.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(certificate.Leaf)
+
+	got, err := checkCertExpiry(server.URL, &tls.Config{RootCAs: roots})
+
+	if err != nil {
+		t.Fatalf("did not expect an error for an expired certificate but got %v", err)
+	}
+
+	if !got.Equal(expiredAt) {
+		t.Errorf("got expiry %v want %v", got, expiredAt)
+	}
+}
+
+// newSelfSignedCertificate creates a certificate for 127.0.0.1 which is valid between notBefore and notAfter
+func newSelfSignedCertificate(t *testing.T, notBefore, notAfter time.Time) tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("could not generate key, %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{Organization: []string{"Acme Co"}},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("could not create certificate, %v", err)
+	}
+
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("could not parse certificate, %v", err)
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
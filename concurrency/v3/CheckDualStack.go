@@ -0,0 +1,52 @@
+package concurrency
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+)
+
+const dualStackTimeout = 10 * time.Second
+
+// DualStack is whether a url could be reached over IPv4 and over IPv6
+type DualStack struct {
+	V4, V6 bool
+}
+
+// CheckDualStack requests url once over IPv4 and once over IPv6, reporting
+// whether each got a response. A host without an address in one of the
+// families reports false for it
+func CheckDualStack(url string) DualStack {
+	return checkDualStack(url, nil)
+}
+
+func checkDualStack(url string, resolver *net.Resolver) DualStack {
+	return DualStack{
+		V4: reachableOver("tcp4", url, resolver),
+		V6: reachableOver("tcp6", url, resolver),
+	}
+}
+
+// reachableOver reports whether url gives any response when every connection is dialled on network
+func reachableOver(network, url string, resolver *net.Resolver) bool {
+	dialer := &net.Dialer{Timeout: dualStackTimeout, Resolver: resolver}
+
+	client := &http.Client{
+		Timeout: dualStackTimeout,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, address string) (net.Conn, error) {
+				return dialer.DialContext(ctx, network, address)
+			},
+		},
+	}
+	defer client.CloseIdleConnections()
+
+	response, err := client.Head(url)
+	if err != nil {
+		return false
+	}
+	response.Body.Close()
+
+	return true
+}
@@ -0,0 +1,53 @@
+package concurrency
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckDualStack(t *testing.T) {
+	listener, err := net.Listen("tcp", "[::]:0")
+	if err != nil {
+		t.Skipf("could not listen on both families, %v", err)
+	}
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Listener.Close()
+	server.Listener = listener
+	server.Start()
+	defer server.Close()
+
+	_, port, _ := net.SplitHostPort(listener.Addr().String())
+
+	t.Run("a server bound to both families is reachable over both", func(t *testing.T) {
+		resolver, closeDNS := newStubResolver(t, "dualstack.test.", net.IPv4(127, 0, 0, 1), net.IPv6loopback)
+		defer closeDNS()
+
+		got := checkDualStack("http://dualstack.test:"+port, resolver)
+		want := DualStack{V4: true, V6: true}
+
+		if got != want {
+			t.Errorf("got %+v want %+v", got, want)
+		}
+	})
+
+	t.Run("a host with only an IPv4 address is not reachable over IPv6", func(t *testing.T) {
+		resolver, closeDNS := newStubResolver(t, "v4only.test.", net.IPv4(127, 0, 0, 1))
+		defer closeDNS()
+
+		got := checkDualStack("http://v4only.test:"+port, resolver)
+		want := DualStack{V4: true, V6: false}
+
+		if got != want {
+			t.Errorf("got %+v want %+v", got, want)
+		}
+	})
+
+	t.Run("unreachable urls are down on both", func(t *testing.T) {
+		if got := CheckDualStack("waat://furhurterwe.geds"); got != (DualStack{}) {
+			t.Errorf("got %+v want neither family up", got)
+		}
+	})
+}
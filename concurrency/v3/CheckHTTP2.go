@@ -0,0 +1,72 @@
+package concurrency
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const http2Timeout = 10 * time.Second
+
+// HTTP2Support is the result of checking whether a url is served over HTTP/2
+type HTTP2Support struct {
+	Supported bool
+	Err       error
+}
+
+// CheckHTTP2 requests url, offering HTTP/2, and reports whether the response came back over it
+func CheckHTTP2(url string) (bool, error) {
+	return checkHTTP2(url, nil)
+}
+
+// CheckHTTP2Batch checks each url for HTTP/2 support concurrently, returning a
+// map of urls to whether they support it or the error found trying to find out
+func CheckHTTP2Batch(urls []string) map[string]HTTP2Support {
+	return checkHTTP2Batch(urls, nil)
+}
+
+type http2Result struct {
+	url     string
+	support HTTP2Support
+}
+
+func checkHTTP2Batch(urls []string, config *tls.Config) map[string]HTTP2Support {
+	results := make(map[string]HTTP2Support)
+	resultChannel := make(chan http2Result, len(urls))
+
+	for _, u := range urls {
+		go func(u string) {
+			supported, err := checkHTTP2(u, config)
+			resultChannel <- http2Result{u, HTTP2Support{supported, err}}
+		}(u)
+	}
+
+	for i := 0; i < len(urls); i++ {
+		result := <-resultChannel
+		results[result.url] = result.support
+	}
+
+	return results
+}
+
+func checkHTTP2(url string, config *tls.Config) (bool, error) {
+	client := &http.Client{
+		Timeout: http2Timeout,
+		Transport: &http.Transport{
+			// a custom TLS config turns HTTP/2 off unless we ask for it
+			ForceAttemptHTTP2: true,
+			// the transport adds to the config's protocols, so each one needs its own copy
+			TLSClientConfig: config.Clone(),
+		},
+	}
+	defer client.CloseIdleConnections()
+
+	response, err := client.Head(url)
+	if err != nil {
+		return false, fmt.Errorf("problem requesting %q, %v", url, err)
+	}
+	response.Body.Close()
+
+	return response.ProtoMajor == 2, nil
+}
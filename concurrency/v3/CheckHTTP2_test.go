@@ -0,0 +1,59 @@
+package concurrency
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckHTTP2(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	http2Server := httptest.NewUnstartedServer(handler)
+	http2Server.EnableHTTP2 = true
+	http2Server.StartTLS()
+	defer http2Server.Close()
+
+	http1Server := httptest.NewTLSServer(handler)
+	defer http1Server.Close()
+
+	// the test servers' certificates are self signed, so trust them explicitly
+	config := &tls.Config{RootCAs: http2Server.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs}
+
+	t.Run("detects a server using HTTP/2", func(t *testing.T) {
+		got, err := checkHTTP2(http2Server.URL, config)
+
+		if err != nil {
+			t.Fatalf("did not expect an error but got %v", err)
+		}
+
+		if !got {
+			t.Errorf("expected %s to support HTTP/2", http2Server.URL)
+		}
+	})
+
+	t.Run("detects a server only using HTTP/1.1", func(t *testing.T) {
+		got, err := checkHTTP2(http1Server.URL, config)
+
+		if err != nil {
+			t.Fatalf("did not expect an error but got %v", err)
+		}
+
+		if got {
+			t.Errorf("expected %s not to support HTTP/2", http1Server.URL)
+		}
+	})
+
+	t.Run("checks a batch of urls", func(t *testing.T) {
+		got := checkHTTP2Batch([]string{http2Server.URL, "waat://furhurterwe.geds"}, config)
+
+		if got[http2Server.URL] != (HTTP2Support{Supported: true}) {
+			t.Errorf("expected HTTP/2 for %s but got %+v", http2Server.URL, got[http2Server.URL])
+		}
+
+		if got["waat://furhurterwe.geds"].Err == nil {
+			t.Error("expected an error for waat://furhurterwe.geds")
+		}
+	})
+}
@@ -0,0 +1,31 @@
+package concurrency
+
+// CheckInBatches checks urls in chunks of batchSize, each chunk concurrently
+// with CheckWebsites, calling onBatch with a chunk's results once all of them
+// are in and before the next chunk starts. It returns every result merged
+// together. A batchSize below 1 checks all the urls in one batch
+func CheckInBatches(wc WebsiteChecker, urls []string, batchSize int, onBatch func(batch map[string]bool)) map[string]bool {
+	if batchSize < 1 {
+		batchSize = len(urls)
+	}
+
+	results := make(map[string]bool)
+
+	for start := 0; start < len(urls); start += batchSize {
+		end := start + batchSize
+		if end > len(urls) {
+			end = len(urls)
+		}
+
+		batch := CheckWebsites(wc, urls[start:end])
+		for url, up := range batch {
+			results[url] = up
+		}
+
+		if onBatch != nil {
+			onBatch(batch)
+		}
+	}
+
+	return results
+}
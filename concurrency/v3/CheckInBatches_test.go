@@ -0,0 +1,30 @@
+package concurrency
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckInBatches(t *testing.T) {
+	websites := []string{
+		"http://google.com",
+		"http://blog.gypsydave5.com",
+		"waat://furhurterwe.geds",
+		"http://golang.org",
+		"http://quii.dev",
+	}
+
+	var batchSizes []int
+	got := CheckInBatches(mockWebsiteChecker, websites, 2, func(batch map[string]bool) {
+		batchSizes = append(batchSizes, len(batch))
+	})
+
+	if want := []int{2, 2, 1}; !reflect.DeepEqual(batchSizes, want) {
+		t.Errorf("got batches of %v want %v", batchSizes, want)
+	}
+
+	want := CheckWebsites(mockWebsiteChecker, websites)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
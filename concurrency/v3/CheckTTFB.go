@@ -0,0 +1,75 @@
+package concurrency
+
+import (
+	"net/http"
+	"net/http/httptrace"
+	"time"
+)
+
+const ttfbTimeout = 10 * time.Second
+
+// CheckTTFB requests url and returns how long it took for the first byte of
+// the response to arrive, including looking up the host and connecting to it.
+// The body is not read, so slow bodies do not change the result. Redirects are
+// not followed, so for a url which redirects it is the time to the first byte
+// of the redirect
+func CheckTTFB(url string) (time.Duration, error) {
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var firstByte time.Time
+	trace := &httptrace.ClientTrace{
+		GotFirstResponseByte: func() { firstByte = time.Now() },
+	}
+	request = request.WithContext(httptrace.WithClientTrace(request.Context(), trace))
+
+	client := &http.Client{
+		Timeout: ttfbTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	start := time.Now()
+	response, err := client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	response.Body.Close()
+
+	return firstByte.Sub(start), nil
+}
+
+// TTFB is the time to first byte of a url, or the error found trying to measure it
+type TTFB struct {
+	Duration time.Duration
+	Err      error
+}
+
+type ttfbResult struct {
+	url  string
+	ttfb TTFB
+}
+
+// CheckTTFBBatch measures the time to first byte of each url concurrently,
+// returning a map of urls to it or the error found trying to measure it
+func CheckTTFBBatch(urls []string) map[string]TTFB {
+	results := make(map[string]TTFB)
+	resultChannel := make(chan ttfbResult, len(urls))
+
+	for _, url := range urls {
+		go func(u string) {
+			duration, err := CheckTTFB(u)
+			resultChannel <- ttfbResult{u, TTFB{duration, err}}
+		}(url)
+	}
+
+	for i := 0; i < len(urls); i++ {
+		result := <-resultChannel
+		results[result.url] = result.ttfb
+	}
+
+	return results
+}
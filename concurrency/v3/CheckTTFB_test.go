@@ -0,0 +1,83 @@
+package concurrency
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCheckTTFB(t *testing.T) {
+	const thinkTime, trickleTime = 50 * time.Millisecond, 500 * time.Millisecond
+
+	// waits before sending anything, then sends the rest of the body slowly
+	slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(thinkTime)
+		w.Write([]byte("first"))
+		w.(http.Flusher).Flush()
+
+		select {
+		case <-time.After(trickleTime):
+			w.Write([]byte("rest"))
+		case <-r.Context().Done():
+		}
+	}))
+	defer slowServer.Close()
+
+	t.Run("measures up to the first byte and not the whole body", func(t *testing.T) {
+		ttfb, err := CheckTTFB(slowServer.URL)
+
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+
+		if ttfb < thinkTime || ttfb >= trickleTime {
+			t.Errorf("got a ttfb of %v, want at least %v and less than %v", ttfb, thinkTime, trickleTime)
+		}
+	})
+
+	t.Run("errors have no ttfb", func(t *testing.T) {
+		ttfb, err := CheckTTFB("waat://furhurterwe.geds")
+
+		if err == nil {
+			t.Error("expected an error")
+		}
+
+		if ttfb != 0 {
+			t.Errorf("got a ttfb of %v want 0", ttfb)
+		}
+	})
+
+	t.Run("redirects are not followed", func(t *testing.T) {
+		redirectServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, slowServer.URL, http.StatusFound)
+		}))
+		defer redirectServer.Close()
+
+		ttfb, err := CheckTTFB(redirectServer.URL)
+
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+
+		if ttfb >= thinkTime {
+			t.Errorf("got a ttfb of %v, want less than the %v the redirect's target takes", ttfb, thinkTime)
+		}
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		got := CheckTTFBBatch([]string{slowServer.URL, "waat://furhurterwe.geds"})
+
+		if len(got) != 2 {
+			t.Fatalf("got %d results want 2, %v", len(got), got)
+		}
+
+		if slow := got[slowServer.URL]; slow.Err != nil || slow.Duration < thinkTime {
+			t.Errorf("got %+v for %s, want a ttfb of at least %v", slow, slowServer.URL, thinkTime)
+		}
+
+		if unreachable := got["waat://furhurterwe.geds"]; unreachable.Err == nil || unreachable.Duration != 0 {
+			t.Errorf("got %+v for an unreachable url, want an error and no ttfb", unreachable)
+		}
+	})
+}
@@ -0,0 +1,25 @@
+package concurrency
+
+import "net/url"
+
+// CheckValidated checks urls like CheckWebsites, except urls which url.Parse
+// rejects, or which have no scheme or host, are not checked and are returned in
+// invalid instead, in the order they were given
+func CheckValidated(wc WebsiteChecker, urls []string) (results map[string]bool, invalid []string) {
+	var valid []string
+
+	for _, u := range urls {
+		if isCheckable(u) {
+			valid = append(valid, u)
+		} else {
+			invalid = append(invalid, u)
+		}
+	}
+
+	return CheckWebsites(wc, valid), invalid
+}
+
+func isCheckable(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
@@ -0,0 +1,47 @@
+package concurrency
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestCheckValidated(t *testing.T) {
+	var mu sync.Mutex
+	var checked []string
+	checker := func(url string) bool {
+		mu.Lock()
+		checked = append(checked, url)
+		mu.Unlock()
+		return mockWebsiteChecker(url)
+	}
+
+	urls := []string{
+		"http://google.com",
+		"://no-scheme.com",
+		"waat://furhurterwe.geds",
+		"not a url",
+		"http://[::1",
+	}
+
+	results, invalid := CheckValidated(checker, urls)
+
+	wantResults := map[string]bool{
+		"http://google.com":       true,
+		"waat://furhurterwe.geds": false,
+	}
+	if !reflect.DeepEqual(results, wantResults) {
+		t.Errorf("got results %v want %v", results, wantResults)
+	}
+
+	wantInvalid := []string{"://no-scheme.com", "not a url", "http://[::1"}
+	if !reflect.DeepEqual(invalid, wantInvalid) {
+		t.Errorf("got invalid %q want %q", invalid, wantInvalid)
+	}
+
+	sort.Strings(checked)
+	if want := []string{"http://google.com", "waat://furhurterwe.geds"}; !reflect.DeepEqual(checked, want) {
+		t.Errorf("checked %q but only wanted %q checked", checked, want)
+	}
+}
@@ -1,5 +1,7 @@
 package concurrency
 
+import "log"
+
 // WebsiteChecker checks a url, returning a bool
 type WebsiteChecker func(string) bool
 type result struct {
@@ -7,22 +9,52 @@ type result struct {
 	bool
 }
 
+// Sink receives the result of each url checked, for example to store or export it
+type Sink interface {
+	Record(url string, ok bool)
+}
+
 // CheckWebsites takes a WebsiteChecker and a slice of urls and returns  a map
-// of urls to the result of checking each url with the WebsiteChecker function
-func CheckWebsites(wc WebsiteChecker, urls []string) map[string]bool {
+// of urls to the result of checking each url with the WebsiteChecker function.
+// Each result is also recorded to every sink, one at a time, so a Sink does
+// not need to be safe for concurrent use
+func CheckWebsites(wc WebsiteChecker, urls []string, sinks ...Sink) map[string]bool {
+	return checkWebsites(wc, urls, len(urls), sinks...)
+}
+
+// checkWebsites does the work for CheckWebsites with a results channel of the
+// given buffer size, so the benchmarks can compare buffered and unbuffered
+func checkWebsites(wc WebsiteChecker, urls []string, bufferSize int, sinks ...Sink) map[string]bool {
 	results := make(map[string]bool)
-	resultChannel := make(chan result)
+	resultChannel := make(chan result, bufferSize)
 
 	for _, url := range urls {
 		go func(u string) {
-			resultChannel <- result{u, wc(u)}
+			resultChannel <- result{u, safeCheck(wc, u)}
 		}(url)
 	}
 
 	for i := 0; i < len(urls); i++ {
 		result := <-resultChannel
 		results[result.string] = result.bool
+
+		for _, sink := range sinks {
+			sink.Record(result.string, result.bool)
+		}
 	}
 
 	return results
 }
+
+// safeCheck runs wc, treating a panic as the url being down so one bad check
+// cannot take down the whole run
+func safeCheck(wc WebsiteChecker, url string) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("checking %s panicked, treating it as down: %v", url, r)
+			ok = false
+		}
+	}()
+
+	return wc(url)
+}
@@ -1,6 +1,8 @@
 package concurrency
 
 import (
+	"fmt"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -20,3 +22,76 @@ func BenchmarkCheckWebsites(b *testing.B) {
 		CheckWebsites(slowStubWebsiteChecker, urls)
 	}
 }
+
+// BenchmarkCheckWebsitesBuffering compares an unbuffered results channel with
+// one buffered to len(urls).
+//
+// On a typical machine both take ~20.5ms for 100 urls and ~22.7ms for 1000 urls;
+// the run time is dominated by the 20ms check, so buffering is within noise on
+// the wall clock. What it does change is that goroutines no longer block
+// waiting for the receiving loop, so they finish (and are freed) as soon as
+// their check returns rather than queueing up behind each other on the send.
+func BenchmarkCheckWebsitesBuffering(b *testing.B) {
+	for _, size := range []int{100, 1000} {
+		urls := make([]string, size)
+		for i := 0; i < len(urls); i++ {
+			urls[i] = fmt.Sprintf("http://%d.com", i)
+		}
+
+		b.Run(fmt.Sprintf("unbuffered %d urls", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				checkWebsites(slowStubWebsiteChecker, urls, 0)
+			}
+		})
+
+		b.Run(fmt.Sprintf("buffered %d urls", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				checkWebsites(slowStubWebsiteChecker, urls, len(urls))
+			}
+		})
+	}
+}
+
+func TestCheckWebsitesBufferingGivesSameResults(t *testing.T) {
+	websites := []string{
+		"http://google.com",
+		"http://blog.gypsydave5.com",
+		"waat://furhurterwe.geds",
+	}
+
+	unbuffered := checkWebsites(mockWebsiteChecker, websites, 0)
+	buffered := checkWebsites(mockWebsiteChecker, websites, len(websites))
+
+	if !reflect.DeepEqual(unbuffered, buffered) {
+		t.Fatalf("buffered results %v differ from unbuffered %v", buffered, unbuffered)
+	}
+}
+
+// BenchmarkCheckAuto compares CheckAuto's worker pool with CheckWebsites'
+// goroutine per url.
+//
+// With GOMAXPROCS=1, so 16 workers, CheckAuto takes ~142ms for 100 urls and
+// ~1.27s for 1000 urls against ~20ms and ~22ms unbounded. The stub check only
+// sleeps, so running every check at once is free here; the pool gives up that
+// wall clock time in return for never having more than 16 checks (and so open
+// connections) in flight per CPU.
+func BenchmarkCheckAuto(b *testing.B) {
+	for _, size := range []int{100, 1000} {
+		urls := make([]string, size)
+		for i := 0; i < len(urls); i++ {
+			urls[i] = fmt.Sprintf("http://%d.com", i)
+		}
+
+		b.Run(fmt.Sprintf("unbounded %d urls", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				CheckWebsites(slowStubWebsiteChecker, urls)
+			}
+		})
+
+		b.Run(fmt.Sprintf("auto %d urls", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				CheckAuto(slowStubWebsiteChecker, urls)
+			}
+		})
+	}
+}
@@ -31,3 +31,63 @@ func TestCheckWebsites(t *testing.T) {
 		t.Fatalf("Wanted %v, got %v", want, got)
 	}
 }
+
+func TestCheckWebsitesSurvivesPanics(t *testing.T) {
+	panickingChecker := func(url string) bool {
+		if url == "waat://furhurterwe.geds" {
+			panic("oh no")
+		}
+		return true
+	}
+
+	websites := []string{
+		"http://google.com",
+		"http://blog.gypsydave5.com",
+		"waat://furhurterwe.geds",
+	}
+
+	want := map[string]bool{
+		"http://google.com":          true,
+		"http://blog.gypsydave5.com": true,
+		"waat://furhurterwe.geds":    false,
+	}
+
+	got := CheckWebsites(panickingChecker, websites)
+
+	if !reflect.DeepEqual(want, got) {
+		t.Fatalf("Wanted %v, got %v", want, got)
+	}
+}
+
+type recordingSink struct {
+	records map[string][]bool
+}
+
+func (r *recordingSink) Record(url string, ok bool) {
+	r.records[url] = append(r.records[url], ok)
+}
+
+func TestCheckWebsitesRecordsToSinks(t *testing.T) {
+	websites := []string{
+		"http://google.com",
+		"http://blog.gypsydave5.com",
+		"waat://furhurterwe.geds",
+	}
+
+	want := map[string][]bool{
+		"http://google.com":          {true},
+		"http://blog.gypsydave5.com": {true},
+		"waat://furhurterwe.geds":    {false},
+	}
+
+	first := &recordingSink{map[string][]bool{}}
+	second := &recordingSink{map[string][]bool{}}
+
+	CheckWebsites(mockWebsiteChecker, websites, first, second)
+
+	for _, sink := range []*recordingSink{first, second} {
+		if !reflect.DeepEqual(want, sink.records) {
+			t.Errorf("Wanted %v, got %v", want, sink.records)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package concurrency
+
+import "sync/atomic"
+
+// CheckWithRetryBudget checks urls concurrently like CheckWebsites, retrying
+// failed checks while there are retries left in a budget shared by the whole
+// batch, so at most len(urls)+totalRetries checks are ever made
+func CheckWithRetryBudget(checker WebsiteChecker, urls []string, totalRetries int) map[string]bool {
+	budget := int64(totalRetries)
+
+	withRetries := func(url string) bool {
+		for {
+			if checker(url) {
+				return true
+			}
+			if atomic.AddInt64(&budget, -1) < 0 {
+				return false
+			}
+		}
+	}
+
+	return CheckWebsites(withRetries, urls)
+}
@@ -0,0 +1,87 @@
+package concurrency
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCheckWithRetryBudget(t *testing.T) {
+	websites := []string{
+		"http://google.com",
+		"http://blog.gypsydave5.com",
+		"waat://furhurterwe.geds",
+	}
+
+	t.Run("never makes more checks than the budget allows", func(t *testing.T) {
+		var calls int64
+		alwaysDown := func(_ string) bool {
+			atomic.AddInt64(&calls, 1)
+			return false
+		}
+
+		got := CheckWithRetryBudget(alwaysDown, websites, 4)
+
+		want := map[string]bool{
+			"http://google.com":          false,
+			"http://blog.gypsydave5.com": false,
+			"waat://furhurterwe.geds":    false,
+		}
+
+		if !reflect.DeepEqual(want, got) {
+			t.Fatalf("Wanted %v, got %v", want, got)
+		}
+
+		if calls != int64(len(websites)+4) {
+			t.Errorf("got %d checks want %d", calls, len(websites)+4)
+		}
+	})
+
+	t.Run("retries flaky checks until they pass", func(t *testing.T) {
+		var mu sync.Mutex
+		var calls int
+		failures := map[string]int{"http://google.com": 2}
+
+		flaky := func(url string) bool {
+			mu.Lock()
+			defer mu.Unlock()
+			calls++
+			if failures[url] > 0 {
+				failures[url]--
+				return false
+			}
+			return true
+		}
+
+		got := CheckWithRetryBudget(flaky, websites, 5)
+
+		want := map[string]bool{
+			"http://google.com":          true,
+			"http://blog.gypsydave5.com": true,
+			"waat://furhurterwe.geds":    true,
+		}
+
+		if !reflect.DeepEqual(want, got) {
+			t.Fatalf("Wanted %v, got %v", want, got)
+		}
+
+		if calls > len(websites)+5 {
+			t.Errorf("got %d checks, more than the %d allowed", calls, len(websites)+5)
+		}
+	})
+
+	t.Run("no budget means no retries", func(t *testing.T) {
+		var calls int64
+		alwaysDown := func(_ string) bool {
+			atomic.AddInt64(&calls, 1)
+			return false
+		}
+
+		CheckWithRetryBudget(alwaysDown, websites, 0)
+
+		if calls != int64(len(websites)) {
+			t.Errorf("got %d checks want %d", calls, len(websites))
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package concurrency
+
+import "time"
+
+// TimedChecker checks a url, returning whether it is up and how long the check took
+type TimedChecker func(string) (bool, time.Duration)
+
+// SLAResult is whether a url was up and whether it responded within the target latency
+type SLAResult struct {
+	OK        bool
+	WithinSLA bool
+}
+
+type slaResult struct {
+	url    string
+	result SLAResult
+}
+
+// CheckWithSLA checks urls concurrently, like CheckWebsites, also recording
+// whether each check took less than target
+func CheckWithSLA(checker TimedChecker, urls []string, target time.Duration) map[string]SLAResult {
+	results := make(map[string]SLAResult)
+	resultChannel := make(chan slaResult, len(urls))
+
+	for _, url := range urls {
+		go func(u string) {
+			ok, took := checker(u)
+			resultChannel <- slaResult{u, SLAResult{OK: ok, WithinSLA: took < target}}
+		}(url)
+	}
+
+	for i := 0; i < len(urls); i++ {
+		result := <-resultChannel
+		results[result.url] = result.result
+	}
+
+	return results
+}
@@ -0,0 +1,37 @@
+package concurrency
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCheckWithSLA(t *testing.T) {
+	durations := map[string]time.Duration{
+		"http://google.com":          50 * time.Millisecond,
+		"http://blog.gypsydave5.com": 250 * time.Millisecond,
+		"http://exactly.com":         100 * time.Millisecond,
+		"waat://furhurterwe.geds":    10 * time.Millisecond,
+	}
+
+	checker := func(url string) (bool, time.Duration) {
+		return mockWebsiteChecker(url), durations[url]
+	}
+
+	urls := make([]string, 0, len(durations))
+	for url := range durations {
+		urls = append(urls, url)
+	}
+
+	got := CheckWithSLA(checker, urls, 100*time.Millisecond)
+	want := map[string]SLAResult{
+		"http://google.com":          {OK: true, WithinSLA: true},
+		"http://blog.gypsydave5.com": {OK: true, WithinSLA: false},
+		"http://exactly.com":         {OK: true, WithinSLA: false},
+		"waat://furhurterwe.geds":    {OK: false, WithinSLA: true},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v want %v", got, want)
+	}
+}
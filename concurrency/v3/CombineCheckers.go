@@ -0,0 +1,27 @@
+package concurrency
+
+// And returns a WebsiteChecker which is true only when every checker passes.
+// Checkers are run in order and it stops at the first one that fails.
+func And(checkers ...WebsiteChecker) WebsiteChecker {
+	return func(url string) bool {
+		for _, check := range checkers {
+			if !check(url) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or returns a WebsiteChecker which is true when any of the checkers passes.
+// Checkers are run in order and it stops at the first one that succeeds.
+func Or(checkers ...WebsiteChecker) WebsiteChecker {
+	return func(url string) bool {
+		for _, check := range checkers {
+			if check(url) {
+				return true
+			}
+		}
+		return false
+	}
+}
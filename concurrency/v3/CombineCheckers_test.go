@@ -0,0 +1,84 @@
+package concurrency
+
+import "testing"
+
+type spyChecker struct {
+	result bool
+	calls  int
+}
+
+func (s *spyChecker) Check(_ string) bool {
+	s.calls++
+	return s.result
+}
+
+func TestAnd(t *testing.T) {
+	t.Run("fails when one checker fails and skips the rest", func(t *testing.T) {
+		head := &spyChecker{result: true}
+		content := &spyChecker{result: false}
+		never := &spyChecker{result: true}
+
+		got := And(head.Check, content.Check, never.Check)("http://google.com")
+
+		if got {
+			t.Error("expected And to fail when a checker fails")
+		}
+
+		assertCalls(t, head, 1)
+		assertCalls(t, content, 1)
+		assertCalls(t, never, 0)
+	})
+
+	t.Run("passes when every checker passes", func(t *testing.T) {
+		head := &spyChecker{result: true}
+		content := &spyChecker{result: true}
+
+		if !And(head.Check, content.Check)("http://google.com") {
+			t.Error("expected And to pass when all checkers pass")
+		}
+	})
+
+	t.Run("plugs into CheckWebsites", func(t *testing.T) {
+		head := &spyChecker{result: true}
+
+		got := CheckWebsites(And(head.Check, mockWebsiteChecker), []string{"waat://furhurterwe.geds"})
+
+		if got["waat://furhurterwe.geds"] {
+			t.Error("expected waat://furhurterwe.geds to be down")
+		}
+	})
+}
+
+func TestOr(t *testing.T) {
+	t.Run("passes when one checker passes and skips the rest", func(t *testing.T) {
+		head := &spyChecker{result: false}
+		content := &spyChecker{result: true}
+		never := &spyChecker{result: false}
+
+		got := Or(head.Check, content.Check, never.Check)("http://google.com")
+
+		if !got {
+			t.Error("expected Or to pass when a checker passes")
+		}
+
+		assertCalls(t, head, 1)
+		assertCalls(t, content, 1)
+		assertCalls(t, never, 0)
+	})
+
+	t.Run("fails when every checker fails", func(t *testing.T) {
+		head := &spyChecker{result: false}
+		content := &spyChecker{result: false}
+
+		if Or(head.Check, content.Check)("http://google.com") {
+			t.Error("expected Or to fail when all checkers fail")
+		}
+	})
+}
+
+func assertCalls(t *testing.T, checker *spyChecker, want int) {
+	t.Helper()
+	if checker.calls != want {
+		t.Errorf("got %d calls want %d", checker.calls, want)
+	}
+}
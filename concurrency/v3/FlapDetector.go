@@ -0,0 +1,67 @@
+package concurrency
+
+import (
+	"errors"
+	"sort"
+)
+
+// FlapDetector remembers the last few CheckWebsites runs so it can spot urls
+// which keep going up and down
+type FlapDetector struct {
+	window    int
+	threshold int
+	runs      []map[string]bool
+}
+
+// ErrInvalidWindow means a FlapDetector was asked to look at fewer than one run
+var ErrInvalidWindow = errors.New("flap detector window must be at least one run")
+
+// NewFlapDetector creates a FlapDetector which looks at the last window runs
+// and flags urls whose result changed more than threshold times across them.
+// The window must be at least one run
+func NewFlapDetector(window, threshold int) (*FlapDetector, error) {
+	if window < 1 {
+		return nil, ErrInvalidWindow
+	}
+
+	return &FlapDetector{window: window, threshold: threshold}, nil
+}
+
+// Record adds the results of a CheckWebsites run, forgetting the oldest run once there are more than window
+func (f *FlapDetector) Record(results map[string]bool) {
+	run := make(map[string]bool, len(results))
+	for url, up := range results {
+		run[url] = up
+	}
+
+	f.runs = append(f.runs, run)
+	if len(f.runs) > f.window {
+		f.runs = f.runs[len(f.runs)-f.window:]
+	}
+}
+
+// Flapping returns, sorted, the urls whose result changed more than threshold
+// times over the runs in the window. Runs which did not check a url are skipped over for it
+func (f *FlapDetector) Flapping() []string {
+	last := make(map[string]bool)
+	changes := make(map[string]int)
+
+	for _, run := range f.runs {
+		for url, up := range run {
+			if was, seen := last[url]; seen && was != up {
+				changes[url]++
+			}
+			last[url] = up
+		}
+	}
+
+	flapping := []string{}
+	for url, count := range changes {
+		if count > f.threshold {
+			flapping = append(flapping, url)
+		}
+	}
+	sort.Strings(flapping)
+
+	return flapping
+}
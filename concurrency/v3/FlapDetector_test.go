@@ -0,0 +1,68 @@
+package concurrency
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlapDetector(t *testing.T) {
+	record := func(detector *FlapDetector, runs int) {
+		for i := 0; i < runs; i++ {
+			detector.Record(map[string]bool{
+				"http://google.com":          true,
+				"http://blog.gypsydave5.com": i%2 == 0,
+			})
+		}
+	}
+
+	t.Run("flags a url which keeps changing", func(t *testing.T) {
+		detector := newFlapDetector(t, 5, 2)
+		record(detector, 5)
+
+		assertFlapping(t, detector, []string{"http://blog.gypsydave5.com"})
+	})
+
+	t.Run("changes at the threshold are not flapping", func(t *testing.T) {
+		detector := newFlapDetector(t, 3, 2)
+		record(detector, 3)
+
+		assertFlapping(t, detector, []string{})
+	})
+
+	t.Run("only the last runs in the window count", func(t *testing.T) {
+		detector := newFlapDetector(t, 3, 1)
+		record(detector, 4)
+
+		// the blog settles down for the three most recent runs
+		for i := 0; i < 3; i++ {
+			detector.Record(map[string]bool{"http://blog.gypsydave5.com": true})
+		}
+
+		assertFlapping(t, detector, []string{})
+	})
+}
+
+func assertFlapping(t *testing.T, detector *FlapDetector, want []string) {
+	t.Helper()
+	if got := detector.Flapping(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got flapping %q want %q", got, want)
+	}
+}
+
+func TestNewFlapDetector(t *testing.T) {
+	for _, window := range []int{0, -1} {
+		if _, err := NewFlapDetector(window, 1); err != ErrInvalidWindow {
+			t.Errorf("got error %v for a window of %d want %v", err, window, ErrInvalidWindow)
+		}
+	}
+}
+
+func newFlapDetector(t *testing.T, window, threshold int) *FlapDetector {
+	t.Helper()
+
+	detector, err := NewFlapDetector(window, threshold)
+	if err != nil {
+		t.Fatalf("could not create flap detector, %v", err)
+	}
+	return detector
+}
@@ -0,0 +1,82 @@
+package concurrency
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+)
+
+// ContextWebsiteChecker checks a url like a WebsiteChecker, giving up early if ctx is cancelled
+type ContextWebsiteChecker func(ctx context.Context, url string) bool
+
+// LiveResult is sent over the websocket for each url checked by a LiveFeed
+type LiveResult struct {
+	URL string `json:"url"`
+	Up  bool   `json:"up"`
+}
+
+// LiveFeed is a http.Handler which checks the urls given as url query
+// parameters, such as /live?url=http://a.com&url=http://b.com, and streams
+// each LiveResult over a websocket as soon as its check completes. Closing the
+// websocket cancels any checks still running
+type LiveFeed struct {
+	checker  ContextWebsiteChecker
+	upgrader websocket.Upgrader
+}
+
+// NewLiveFeed creates a LiveFeed which checks urls with checker
+func NewLiveFeed(checker ContextWebsiteChecker) *LiveFeed {
+	return &LiveFeed{
+		checker: checker,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+		},
+	}
+}
+
+func (l *LiveFeed) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	urls := r.URL.Query()["url"]
+
+	conn, err := l.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Printf("problem upgrading connection to websockets %v\n", err)
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// the client never sends us anything, so a read only returns when the socket closes
+	go func() {
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				cancel()
+				return
+			}
+		}
+	}()
+
+	results := make(chan LiveResult, len(urls))
+	for _, url := range urls {
+		go func(u string) {
+			results <- LiveResult{u, l.checker(ctx, u)}
+		}(url)
+	}
+
+	for i := 0; i < len(urls); i++ {
+		select {
+		case result := <-results:
+			if err := conn.WriteJSON(result); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
@@ -0,0 +1,76 @@
+package concurrency
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestLiveFeed(t *testing.T) {
+	t.Run("streams a result for each url", func(t *testing.T) {
+		checker := func(_ context.Context, url string) bool {
+			return mockWebsiteChecker(url)
+		}
+		server := httptest.NewServer(NewLiveFeed(checker))
+		defer server.Close()
+
+		ws := dialLiveFeed(t, server, "http://google.com", "waat://furhurterwe.geds")
+		defer ws.Close()
+
+		got := map[string]bool{}
+		for i := 0; i < 2; i++ {
+			var result LiveResult
+			if err := ws.ReadJSON(&result); err != nil {
+				t.Fatalf("could not read result %d, %v", i, err)
+			}
+			got[result.URL] = result.Up
+		}
+
+		if !got["http://google.com"] || got["waat://furhurterwe.geds"] {
+			t.Errorf("got unexpected results %v", got)
+		}
+
+		if _, _, err := ws.ReadMessage(); !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+			t.Errorf("expected the feed to close once every url was checked, got %v", err)
+		}
+	})
+
+	t.Run("closing the socket cancels remaining checks", func(t *testing.T) {
+		cancelled := make(chan string, 1)
+		checker := func(ctx context.Context, url string) bool {
+			<-ctx.Done()
+			cancelled <- url
+			return false
+		}
+		server := httptest.NewServer(NewLiveFeed(checker))
+		defer server.Close()
+
+		ws := dialLiveFeed(t, server, "http://slow.com")
+		ws.Close()
+
+		select {
+		case url := <-cancelled:
+			if url != "http://slow.com" {
+				t.Errorf("got %s cancelled want http://slow.com", url)
+			}
+		case <-time.After(time.Second):
+			t.Error("timed out waiting for the check to be cancelled")
+		}
+	})
+}
+
+func dialLiveFeed(t *testing.T, server *httptest.Server, urls ...string) *websocket.Conn {
+	t.Helper()
+
+	address := "ws" + strings.TrimPrefix(server.URL, "http") + "/live?url=" + strings.Join(urls, "&url=")
+	ws, _, err := websocket.DefaultDialer.Dial(address, nil)
+	if err != nil {
+		t.Fatalf("could not open a ws connection on %s %v", address, err)
+	}
+
+	return ws
+}
@@ -0,0 +1,37 @@
+package concurrency
+
+import (
+	"sync"
+	"time"
+)
+
+// WithNegativeCache wraps checker so that once a url is found to be down it is
+// reported as down, without calling checker again, until ttl has passed. Urls
+// which are up are always checked again
+func WithNegativeCache(checker func(string) bool, ttl time.Duration, now func() time.Time) func(string) bool {
+	var mu sync.Mutex
+	downUntil := make(map[string]time.Time)
+
+	return func(url string) bool {
+		mu.Lock()
+		until, cached := downUntil[url]
+		mu.Unlock()
+
+		if cached && now().Before(until) {
+			return false
+		}
+
+		up := checker(url)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if up {
+			delete(downUntil, url)
+		} else {
+			downUntil[url] = now().Add(ttl)
+		}
+
+		return up
+	}
+}
@@ -0,0 +1,40 @@
+package concurrency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/quii/learn-go-with-tests/internal/clock"
+)
+
+func TestWithNegativeCache(t *testing.T) {
+	t.Run("down results are cached until the ttl passes", func(t *testing.T) {
+		clock := clock.NewFakeClock(time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC))
+		spy := &spyChecker{result: false}
+		check := WithNegativeCache(spy.Check, time.Minute, clock.Now)
+
+		check("http://down.com")
+		clock.Advance(30 * time.Second)
+		if check("http://down.com") {
+			t.Error("expected the cached result to be down")
+		}
+		assertCalls(t, spy, 1)
+
+		clock.Advance(30 * time.Second)
+		check("http://down.com")
+		assertCalls(t, spy, 2)
+	})
+
+	t.Run("up results are not cached", func(t *testing.T) {
+		clock := clock.NewFakeClock(time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC))
+		spy := &spyChecker{result: true}
+		check := WithNegativeCache(spy.Check, time.Minute, clock.Now)
+
+		for i := 0; i < 3; i++ {
+			if !check("http://google.com") {
+				t.Error("expected google to be up")
+			}
+		}
+		assertCalls(t, spy, 3)
+	})
+}
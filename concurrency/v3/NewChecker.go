@@ -0,0 +1,141 @@
+package concurrency
+
+import (
+	"net"
+	"net/http"
+	neturl "net/url"
+	"time"
+)
+
+// defaultCheckerTimeout is how long a checker made by NewChecker waits for a url before treating it as down
+const defaultCheckerTimeout = 10 * time.Second
+
+type checkerConfig struct {
+	followRedirects bool
+	credentials     map[string]Credentials
+	resolver        *net.Resolver
+	timeout         time.Duration
+}
+
+// Credentials are a username and password sent using HTTP basic auth
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// CheckerOption changes how a checker made by NewChecker behaves
+type CheckerOption func(*checkerConfig)
+
+// FollowRedirects sets whether redirects are followed. When they are not, a
+// 3xx response means the url is down. Redirects are followed by default
+func FollowRedirects(follow bool) CheckerOption {
+	return func(c *checkerConfig) {
+		c.followRedirects = follow
+	}
+}
+
+// BasicAuth sets the credentials to send when checking a url. Keys can be a
+// full url or a host, with a full url taking priority. Urls without
+// credentials are checked anonymously
+func BasicAuth(credentials map[string]Credentials) CheckerOption {
+	return func(c *checkerConfig) {
+		c.credentials = credentials
+	}
+}
+
+// WithResolver sets the resolver used to look up the hosts of urls. The system
+// resolver is used by default
+func WithResolver(resolver *net.Resolver) CheckerOption {
+	return func(c *checkerConfig) {
+		c.resolver = resolver
+	}
+}
+
+// WithTimeout sets how long to wait for a url to respond, including following
+// any redirects, before treating it as down. The default is 10 seconds
+func WithTimeout(timeout time.Duration) CheckerOption {
+	return func(c *checkerConfig) {
+		c.timeout = timeout
+	}
+}
+
+func (c checkerConfig) credentialsFor(url string) (Credentials, bool) {
+	if creds, ok := c.credentials[url]; ok {
+		return creds, true
+	}
+
+	parsed, err := neturl.Parse(url)
+	if err != nil {
+		return Credentials{}, false
+	}
+
+	creds, ok := c.credentials[parsed.Host]
+	return creds, ok
+}
+
+// NewChecker creates a WebsiteChecker which, like CheckWebsite, returns true
+// if the url responds to a HEAD request with a 200 status code
+func NewChecker(options ...CheckerOption) WebsiteChecker {
+	return NewCheckerWithPredicate(OnlyOK, options...)
+}
+
+// OnlyOK treats only a 200 status code as up, which is what NewChecker does
+func OnlyOK(statusCode int) bool {
+	return statusCode == http.StatusOK
+}
+
+// SuccessOrRedirect treats any 2xx or 3xx status code as up
+func SuccessOrRedirect(statusCode int) bool {
+	return statusCode >= 200 && statusCode < 400
+}
+
+// NewCheckerWithPredicate creates a WebsiteChecker which returns true if pred
+// is true for the status code of the url's response to a HEAD request. A nil
+// pred uses SuccessOrRedirect
+func NewCheckerWithPredicate(pred func(statusCode int) bool, options ...CheckerOption) WebsiteChecker {
+	if pred == nil {
+		pred = SuccessOrRedirect
+	}
+
+	config := checkerConfig{followRedirects: true, timeout: defaultCheckerTimeout}
+	for _, option := range options {
+		option(&config)
+	}
+
+	client := &http.Client{Timeout: config.timeout}
+
+	if config.resolver != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.DialContext = (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Resolver:  config.resolver,
+		}).DialContext
+		client.Transport = transport
+	}
+
+	if !config.followRedirects {
+		client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+
+	return func(url string) bool {
+		request, err := http.NewRequest(http.MethodHead, url, nil)
+		if err != nil {
+			return false
+		}
+
+		if creds, ok := config.credentialsFor(url); ok {
+			request.SetBasicAuth(creds.Username, creds.Password)
+		}
+
+		response, err := client.Do(request)
+		if err != nil {
+			return false
+		}
+		response.Body.Close()
+
+		return pred(response.StatusCode)
+	}
+}
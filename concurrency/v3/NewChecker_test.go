@@ -0,0 +1,168 @@
+package concurrency
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewChecker(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ok", http.StatusFound)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	t.Run("follows redirects by default", func(t *testing.T) {
+		check := NewChecker()
+
+		assertUp(t, check, server.URL+"/ok", true)
+		assertUp(t, check, server.URL+"/moved", true)
+	})
+
+	t.Run("following redirects", func(t *testing.T) {
+		check := NewChecker(FollowRedirects(true))
+
+		assertUp(t, check, server.URL+"/moved", true)
+	})
+
+	t.Run("not following redirects treats them as down", func(t *testing.T) {
+		check := NewChecker(FollowRedirects(false))
+
+		assertUp(t, check, server.URL+"/ok", true)
+		assertUp(t, check, server.URL+"/moved", false)
+	})
+
+	t.Run("unreachable urls are down", func(t *testing.T) {
+		assertUp(t, NewChecker(), "waat://furhurterwe.geds", false)
+	})
+}
+
+func TestNewCheckerBasicAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "cleo" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	securedURL := server.URL + "/secured"
+	anonymousURL := server.URL + "/anonymous"
+
+	t.Run("credentials by url", func(t *testing.T) {
+		check := NewChecker(BasicAuth(map[string]Credentials{
+			securedURL: {Username: "cleo", Password: "secret"},
+		}))
+
+		assertUp(t, check, securedURL, true)
+		assertUp(t, check, anonymousURL, false)
+	})
+
+	t.Run("credentials by host", func(t *testing.T) {
+		host := strings.TrimPrefix(server.URL, "http://")
+		check := NewChecker(BasicAuth(map[string]Credentials{
+			host: {Username: "cleo", Password: "secret"},
+		}))
+
+		assertUp(t, check, securedURL, true)
+	})
+
+	t.Run("wrong credentials are down", func(t *testing.T) {
+		check := NewChecker(BasicAuth(map[string]Credentials{
+			securedURL: {Username: "cleo", Password: "guess"},
+		}))
+
+		assertUp(t, check, securedURL, false)
+	})
+}
+
+func TestNewCheckerWithPredicate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/no-content", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ok", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/broken", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	t.Run("a strict predicate only accepts 200", func(t *testing.T) {
+		only200 := func(statusCode int) bool { return statusCode == http.StatusOK }
+		check := NewCheckerWithPredicate(only200, FollowRedirects(false))
+
+		assertUp(t, check, server.URL+"/ok", true)
+		assertUp(t, check, server.URL+"/no-content", false)
+		assertUp(t, check, server.URL+"/moved", false)
+	})
+
+	t.Run("SuccessOrRedirect accepts 2xx and 3xx", func(t *testing.T) {
+		check := NewCheckerWithPredicate(SuccessOrRedirect, FollowRedirects(false))
+
+		assertUp(t, check, server.URL+"/no-content", true)
+		assertUp(t, check, server.URL+"/moved", true)
+		assertUp(t, check, server.URL+"/broken", false)
+	})
+
+	t.Run("a nil predicate accepts 2xx and 3xx", func(t *testing.T) {
+		check := NewCheckerWithPredicate(nil, FollowRedirects(false))
+
+		assertUp(t, check, server.URL+"/ok", true)
+		assertUp(t, check, server.URL+"/no-content", true)
+		assertUp(t, check, server.URL+"/moved", true)
+		assertUp(t, check, server.URL+"/broken", false)
+	})
+}
+
+func TestNewCheckerWithResolver(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	resolver, closeDNS := newStubResolver(t, "checker.test.", net.IPv4(127, 0, 0, 1))
+	defer closeDNS()
+
+	_, port, _ := net.SplitHostPort(strings.TrimPrefix(server.URL, "http://"))
+	url := "http://checker.test:" + port
+
+	assertUp(t, NewChecker(WithResolver(resolver)), url, true)
+}
+
+func TestNewCheckerTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+
+	t.Run("a url slower than the timeout is down", func(t *testing.T) {
+		start := time.Now()
+		assertUp(t, NewChecker(WithTimeout(50*time.Millisecond)), server.URL, false)
+
+		if took := time.Since(start); took >= time.Second {
+			t.Errorf("checking took %v, it should have given up after the timeout", took)
+		}
+	})
+
+	t.Run("a url within the timeout is up", func(t *testing.T) {
+		assertUp(t, NewChecker(WithTimeout(5*time.Second)), server.URL, true)
+	})
+}
+
+func assertUp(t *testing.T, check WebsiteChecker, url string, want bool) {
+	t.Helper()
+	if got := check(url); got != want {
+		t.Errorf("checking %s got %t want %t", url, got, want)
+	}
+}
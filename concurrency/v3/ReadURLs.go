@@ -0,0 +1,29 @@
+package concurrency
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ReadURLs reads one url per line from r, ready to pass to CheckWebsites.
+// Whitespace is trimmed and blank lines and lines starting with # are skipped
+func ReadURLs(r io.Reader) ([]string, error) {
+	var urls []string
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		urls = append(urls, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("problem reading urls, %v", err)
+	}
+
+	return urls, nil
+}
@@ -0,0 +1,39 @@
+package concurrency
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadURLs(t *testing.T) {
+	input := strings.NewReader(`# sites to check
+http://google.com
+
+   http://blog.gypsydave5.com   
+	# this one is broken
+waat://furhurterwe.geds
+`)
+
+	got, err := ReadURLs(input)
+
+	if err != nil {
+		t.Fatalf("did not expect an error but got %v", err)
+	}
+
+	want := []string{
+		"http://google.com",
+		"http://blog.gypsydave5.com",
+		"waat://furhurterwe.geds",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q want %q", got, want)
+	}
+
+	results := CheckWebsites(mockWebsiteChecker, got)
+
+	if len(results) != len(want) || results["waat://furhurterwe.geds"] {
+		t.Errorf("unexpected results checking the urls read, %v", results)
+	}
+}
@@ -0,0 +1,41 @@
+package concurrency
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// ResultsToPrometheus writes the results of CheckWebsites in the Prometheus
+// text exposition format, as a website_up gauge per url, sorted by url, which
+// is 1 when it was up and 0 when it was down, and a website_up_total gauge of
+// how many urls were up
+func ResultsToPrometheus(results map[string]bool) string {
+	urls := make([]string, 0, len(results))
+	for url := range results {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+
+	var out strings.Builder
+	up := 0
+
+	out.WriteString("# HELP website_up Whether the website was up when it was checked.\n")
+	out.WriteString("# TYPE website_up gauge\n")
+	for _, url := range urls {
+		value := 0
+		if results[url] {
+			value = 1
+			up++
+		}
+		fmt.Fprintf(&out, "website_up{url=\"%s\"} %d\n", labelEscaper.Replace(url), value)
+	}
+
+	out.WriteString("# HELP website_up_total How many websites were up when they were checked.\n")
+	out.WriteString("# TYPE website_up_total gauge\n")
+	fmt.Fprintf(&out, "website_up_total %d\n", up)
+
+	return out.String()
+}
@@ -0,0 +1,28 @@
+package concurrency
+
+import "testing"
+
+func TestResultsToPrometheus(t *testing.T) {
+	results := map[string]bool{
+		"waat://furhurterwe.geds":    false,
+		"http://google.com":          true,
+		"http://blog.gypsydave5.com": true,
+		`http://quote".com`:          false,
+	}
+
+	got := ResultsToPrometheus(results)
+	want := `# HELP website_up Whether the website was up when it was checked.
+# TYPE website_up gauge
+website_up{url="http://blog.gypsydave5.com"} 1
+website_up{url="http://google.com"} 1
+website_up{url="http://quote\".com"} 0
+website_up{url="waat://furhurterwe.geds"} 0
+# HELP website_up_total How many websites were up when they were checked.
+# TYPE website_up_total gauge
+website_up_total 2
+`
+
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
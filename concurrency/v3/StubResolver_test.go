@@ -0,0 +1,92 @@
+package concurrency
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+)
+
+// newStubResolver creates a resolver backed by a stub dns server which answers
+// A and AAAA queries for host with the IPv4 and IPv6 addresses in ips, and
+// every other query with no answers
+func newStubResolver(t *testing.T, host string, ips ...net.IP) (*net.Resolver, func()) {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("could not start stub dns server, %v", err)
+	}
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if reply := stubDNSReply(buf[:n], host, ips); reply != nil {
+				conn.WriteTo(reply, addr)
+			}
+		}
+	}()
+
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			return net.Dial("udp", conn.LocalAddr().String())
+		},
+	}
+
+	return resolver, func() { conn.Close() }
+}
+
+// stubDNSReply builds the reply to a single question dns query
+func stubDNSReply(query []byte, host string, ips []net.IP) []byte {
+	const headerLength, typeA, typeAAAA = 12, 1, 28
+
+	if len(query) < headerLength {
+		return nil
+	}
+
+	// the question is the name, as length prefixed labels ending in 0, then a type and class
+	end := headerLength
+	var name []string
+	for end < len(query) && query[end] != 0 {
+		length := int(query[end])
+		if end+1+length > len(query) {
+			return nil
+		}
+		name = append(name, string(query[end+1:end+1+length]))
+		end += 1 + length
+	}
+	end += 5
+	if end > len(query) {
+		return nil
+	}
+	questionType := int(query[end-4])<<8 | int(query[end-3])
+
+	var answers [][]byte
+	if strings.EqualFold(strings.Join(name, ".")+".", host) {
+		for _, ip := range ips {
+			if v4 := ip.To4(); v4 != nil && questionType == typeA {
+				answers = append(answers, v4)
+			}
+			if ip.To4() == nil && questionType == typeAAAA {
+				answers = append(answers, ip.To16())
+			}
+		}
+	}
+
+	reply := append([]byte{}, query[:2]...)
+	reply = append(reply, 0x81, 0x80, 0, 1, 0, byte(len(answers)), 0, 0, 0, 0)
+	reply = append(reply, query[headerLength:end]...)
+
+	for _, address := range answers {
+		// a pointer to the name in the question, the question's type, class IN, a ttl of 60 seconds and the address
+		reply = append(reply, 0xc0, headerLength, 0, byte(questionType), 0, 1, 0, 0, 0, 60, 0, byte(len(address)))
+		reply = append(reply, address...)
+	}
+
+	return reply
+}
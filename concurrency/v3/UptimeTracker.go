@@ -0,0 +1,65 @@
+package concurrency
+
+import (
+	"sync"
+	"time"
+
+	"github.com/quii/learn-go-with-tests/internal/clock"
+)
+
+type timedResult struct {
+	at time.Time
+	up bool
+}
+
+// UptimeTracker remembers when each url was checked and whether it was up, so
+// uptime can be worked out over a rolling window
+type UptimeTracker struct {
+	mu      sync.Mutex
+	clock   clock.Clock
+	results map[string][]timedResult
+}
+
+// NewUptimeTracker creates an UptimeTracker which uses clock to decide where a window ends
+func NewUptimeTracker(clock clock.Clock) *UptimeTracker {
+	return &UptimeTracker{
+		clock:   clock,
+		results: make(map[string][]timedResult),
+	}
+}
+
+// Record stores the results of a CheckWebsites run made at the given time
+func (u *UptimeTracker) Record(results map[string]bool, at time.Time) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	for url, up := range results {
+		u.results[url] = append(u.results[url], timedResult{at, up})
+	}
+}
+
+// UptimePct returns the percentage of checks of url within the last window that
+// found it up. A url with no checks in the window has 0% uptime
+func (u *UptimeTracker) UptimePct(url string, window time.Duration) float64 {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	start := u.clock.Now().Add(-window)
+	checks, ups := 0, 0
+
+	for _, result := range u.results[url] {
+		if result.at.Before(start) {
+			continue
+		}
+		checks++
+		if result.up {
+			ups++
+		}
+	}
+
+	if checks == 0 {
+		return 0
+	}
+
+	return 100 * float64(ups) / float64(checks)
+}
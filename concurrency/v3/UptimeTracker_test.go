@@ -0,0 +1,46 @@
+package concurrency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/quii/learn-go-with-tests/internal/clock"
+)
+
+func TestUptimeTracker(t *testing.T) {
+	clock := clock.NewFakeClock(time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC))
+	tracker := NewUptimeTracker(clock)
+
+	// google is up every minute, the blog alternates starting with down
+	for i := 0; i < 10; i++ {
+		tracker.Record(map[string]bool{
+			"http://google.com":          true,
+			"http://blog.gypsydave5.com": i%2 == 1,
+		}, clock.Now())
+		clock.Advance(time.Minute)
+	}
+	// it is now 12:10, the last check was at 12:09
+
+	cases := []struct {
+		url    string
+		window time.Duration
+		want   float64
+	}{
+		{"http://google.com", time.Hour, 100},
+		{"http://blog.gypsydave5.com", time.Hour, 50},
+		{"http://blog.gypsydave5.com", 3 * time.Minute, 2.0 / 3 * 100},
+		{"http://blog.gypsydave5.com", 4 * time.Minute, 50},
+		{"http://blog.gypsydave5.com", 30 * time.Second, 0},
+		{"waat://furhurterwe.geds", time.Hour, 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.url+" over "+c.window.String(), func(t *testing.T) {
+			got := tracker.UptimePct(c.url, c.window)
+
+			if got != c.want {
+				t.Errorf("got %.2f%% want %.2f%%", got, c.want)
+			}
+		})
+	}
+}
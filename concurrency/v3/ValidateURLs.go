@@ -0,0 +1,28 @@
+package concurrency
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ValidateURLs inspects urls without checking them and returns a warning for
+// each problem found, such as a url that appears more than once or one that
+// cannot be parsed. Run it before CheckWebsites to catch bad input early.
+func ValidateURLs(urls []string) []string {
+	var warnings []string
+	seen := make(map[string]bool)
+
+	for _, u := range urls {
+		if seen[u] {
+			warnings = append(warnings, fmt.Sprintf("duplicate url %q", u))
+			continue
+		}
+		seen[u] = true
+
+		if _, err := url.Parse(u); err != nil {
+			warnings = append(warnings, fmt.Sprintf("unparseable url %q: %v", u, err))
+		}
+	}
+
+	return warnings
+}
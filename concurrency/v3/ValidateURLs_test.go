@@ -0,0 +1,77 @@
+package concurrency
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/quii/learn-go-with-tests/internal/collections"
+)
+
+func TestValidateURLs(t *testing.T) {
+	t.Run("no warnings for good input", func(t *testing.T) {
+		websites := []string{
+			"http://google.com",
+			"http://blog.gypsydave5.com",
+		}
+
+		got := ValidateURLs(websites)
+
+		if len(got) != 0 {
+			t.Errorf("expected no warnings but got %v", got)
+		}
+	})
+
+	t.Run("warns about duplicates and malformed urls", func(t *testing.T) {
+		websites := []string{
+			"http://google.com",
+			"http://[::1",
+			"http://google.com",
+			"http://blog.gypsydave5.com",
+			"http://google.com",
+		}
+
+		got := ValidateURLs(websites)
+
+		if len(got) != 3 {
+			t.Fatalf("got %d warnings want 3, %q", len(got), got)
+		}
+
+		if !strings.HasPrefix(got[0], `unparseable url "http://[::1"`) {
+			t.Errorf("expected a warning about the malformed url but got %q", got[0])
+		}
+
+		wantDuplicates := []string{
+			`duplicate url "http://google.com"`,
+			`duplicate url "http://google.com"`,
+		}
+
+		if !reflect.DeepEqual(got[1:], wantDuplicates) {
+			t.Errorf("got %q want %q", got[1:], wantDuplicates)
+		}
+	})
+
+	t.Run("warnings are in the order the urls were given", func(t *testing.T) {
+		websites := []string{
+			"http://google.com",
+			"http://google.com",
+			"http://[::1",
+		}
+
+		got := ValidateURLs(websites)
+
+		assertWarnedAt(t, got, `duplicate url "http://google.com"`, 0)
+	})
+}
+
+func assertWarnedAt(t *testing.T, warnings []string, want string, index int) {
+	t.Helper()
+
+	if !collections.Contains(warnings, want) {
+		t.Fatalf("expected warning %q in %q", want, warnings)
+	}
+
+	if got := collections.IndexOf(warnings, want); got != index {
+		t.Errorf("got warning %q at %d want %d", want, got, index)
+	}
+}
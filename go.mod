@@ -1,8 +1,18 @@
 module github.com/quii/learn-go-with-tests
 
-go 1.12
+go 1.18
 
 require (
+	github.com/alicebob/miniredis/v2 v2.23.0
 	github.com/client9/misspell v0.3.4 // indirect
+	github.com/go-redis/redis/v8 v8.11.5
 	github.com/gorilla/websocket v1.4.0
+	github.com/mattn/go-sqlite3 v1.14.6
+)
+
+require (
+	github.com/alicebob/gopher-json v0.0.0-20200520072559-a9ecdc9d1d3a // indirect
+	github.com/cespare/xxhash/v2 v2.1.2 // indirect
+	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
+	github.com/yuin/gopher-lua v0.0.0-20210529063254-f4c35e4016d9 // indirect
 )
@@ -0,0 +1,30 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	if got := clock.Now(); !got.Equal(start) {
+		t.Errorf("got %v want %v", got, start)
+	}
+
+	clock.Advance(90 * time.Second)
+
+	if got, want := clock.Now(), start.Add(90*time.Second); !got.Equal(want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestRealClock(t *testing.T) {
+	before := time.Now()
+	got := RealClock{}.Now()
+
+	if got.Before(before) || got.After(time.Now()) {
+		t.Errorf("got %v, expected a time between %v and now", got, before)
+	}
+}
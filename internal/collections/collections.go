@@ -0,0 +1,17 @@
+// Package collections holds small generic helpers shared between chapters
+package collections
+
+// Contains reports whether v is in s
+func Contains[T comparable](s []T, v T) bool {
+	return IndexOf(s, v) != -1
+}
+
+// IndexOf returns the index of the first occurrence of v in s, or -1 if it is not there
+func IndexOf[T comparable](s []T, v T) int {
+	for i, item := range s {
+		if item == v {
+			return i
+		}
+	}
+	return -1
+}
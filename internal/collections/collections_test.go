@@ -0,0 +1,66 @@
+package collections
+
+import "testing"
+
+type player struct {
+	Name string
+	Wins int
+}
+
+func TestContains(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		urls := []string{"http://google.com", "http://blog.gypsydave5.com"}
+
+		assertBool(t, Contains(urls, "http://google.com"), true)
+		assertBool(t, Contains(urls, "waat://furhurterwe.geds"), false)
+	})
+
+	t.Run("ints", func(t *testing.T) {
+		numbers := []int{1, 2, 3}
+
+		assertBool(t, Contains(numbers, 3), true)
+		assertBool(t, Contains(numbers, 4), false)
+	})
+
+	t.Run("structs", func(t *testing.T) {
+		league := []player{{"Cleo", 32}, {"Chris", 20}}
+
+		assertBool(t, Contains(league, player{"Chris", 20}), true)
+		assertBool(t, Contains(league, player{"Chris", 21}), false)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		assertBool(t, Contains(nil, "anything"), false)
+	})
+}
+
+func TestIndexOf(t *testing.T) {
+	t.Run("strings", func(t *testing.T) {
+		urls := []string{"http://google.com", "http://blog.gypsydave5.com", "http://google.com"}
+
+		assertIndex(t, IndexOf(urls, "http://google.com"), 0)
+		assertIndex(t, IndexOf(urls, "http://blog.gypsydave5.com"), 1)
+		assertIndex(t, IndexOf(urls, "waat://furhurterwe.geds"), -1)
+	})
+
+	t.Run("structs", func(t *testing.T) {
+		league := []player{{"Cleo", 32}, {"Chris", 20}}
+
+		assertIndex(t, IndexOf(league, player{"Chris", 20}), 1)
+		assertIndex(t, IndexOf(league, player{"Tiest", 14}), -1)
+	})
+}
+
+func assertBool(t *testing.T, got, want bool) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %t want %t", got, want)
+	}
+}
+
+func assertIndex(t *testing.T, got, want int) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got index %d want %d", got, want)
+	}
+}
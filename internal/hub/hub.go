@@ -0,0 +1,80 @@
+// Package hub fans values out to many subscribers without letting a slow one hold up the rest
+package hub
+
+import "sync"
+
+// OverflowPolicy decides what happens when a subscriber's buffer is full
+type OverflowPolicy int
+
+const (
+	// DropNewest discards the value being broadcast for that subscriber
+	DropNewest OverflowPolicy = iota
+
+	// DropOldest discards the oldest value the subscriber has not read yet to make room
+	DropOldest
+)
+
+// Hub broadcasts values to all of its current subscribers
+type Hub[T any] struct {
+	mu          sync.Mutex
+	subscribers map[chan T]struct{}
+	bufferSize  int
+	policy      OverflowPolicy
+}
+
+// New creates a Hub whose subscribers each buffer up to bufferSize values,
+// using policy when a subscriber falls further behind than that
+func New[T any](bufferSize int, policy OverflowPolicy) *Hub[T] {
+	return &Hub[T]{
+		subscribers: make(map[chan T]struct{}),
+		bufferSize:  bufferSize,
+		policy:      policy,
+	}
+}
+
+// Subscribe returns a channel of broadcast values and a function to unsubscribe,
+// which closes the channel. Calling it more than once is safe
+func (h *Hub[T]) Subscribe() (<-chan T, func()) {
+	ch := make(chan T, h.bufferSize)
+
+	h.mu.Lock()
+	h.subscribers[ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			delete(h.subscribers, ch)
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+// Broadcast sends v to every subscriber without blocking
+func (h *Hub[T]) Broadcast(v T) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subscribers {
+		select {
+		case ch <- v:
+			continue
+		default:
+		}
+
+		if h.policy == DropOldest {
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- v:
+			default:
+			}
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package hub
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestHub(t *testing.T) {
+	t.Run("delivers to every subscriber", func(t *testing.T) {
+		h := New[string](10, DropNewest)
+
+		first, unsubscribeFirst := h.Subscribe()
+		defer unsubscribeFirst()
+		second, unsubscribeSecond := h.Subscribe()
+		defer unsubscribeSecond()
+
+		h.Broadcast("Cleo wins")
+		h.Broadcast("Chris wins")
+
+		want := []string{"Cleo wins", "Chris wins"}
+		assertReceived(t, first, want)
+		assertReceived(t, second, want)
+	})
+
+	t.Run("a slow subscriber does not block the others", func(t *testing.T) {
+		h := New[int](10, DropNewest)
+
+		_, unsubscribeSlow := h.Subscribe()
+		defer unsubscribeSlow()
+		fast, unsubscribeFast := h.Subscribe()
+		defer unsubscribeFast()
+
+		done := make(chan struct{})
+		go func() {
+			// more than the slow subscriber, who never reads, can buffer
+			for i := 0; i < 20; i++ {
+				h.Broadcast(i)
+			}
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("broadcasting blocked on the slow subscriber")
+		}
+
+		assertReceived(t, fast, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+		h.Broadcast(99)
+		assertReceived(t, fast, []int{99})
+	})
+
+	t.Run("drop newest keeps what is already buffered", func(t *testing.T) {
+		h := New[int](2, DropNewest)
+		slow, unsubscribe := h.Subscribe()
+
+		for i := 1; i <= 5; i++ {
+			h.Broadcast(i)
+		}
+		unsubscribe()
+
+		assertReceived(t, slow, []int{1, 2})
+	})
+
+	t.Run("drop oldest keeps the latest values", func(t *testing.T) {
+		h := New[int](2, DropOldest)
+		slow, unsubscribe := h.Subscribe()
+
+		for i := 1; i <= 5; i++ {
+			h.Broadcast(i)
+		}
+		unsubscribe()
+
+		assertReceived(t, slow, []int{4, 5})
+	})
+
+	t.Run("unsubscribing stops delivery and closes the channel", func(t *testing.T) {
+		h := New[string](10, DropNewest)
+		ch, unsubscribe := h.Subscribe()
+
+		unsubscribe()
+		unsubscribe()
+		h.Broadcast("nobody is listening")
+
+		if _, open := <-ch; open {
+			t.Error("expected the channel to be closed")
+		}
+	})
+}
+
+// assertReceived reads until it has as many values as want, or the channel closes
+func assertReceived[T any](t *testing.T, ch <-chan T, want []T) {
+	t.Helper()
+
+	var got []T
+	timeout := time.After(time.Second)
+
+	for len(got) < len(want) {
+		select {
+		case v, open := <-ch:
+			if !open {
+				t.Fatalf("channel closed after %v, want %v", got, want)
+			}
+			got = append(got, v)
+		case <-timeout:
+			t.Fatalf("timed out with %v, want %v", got, want)
+		}
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/quii/learn-go-with-tests/internal/clock"
+)
+
+// AuditEvent records a change made to a store: who it was made to, what was done and when
+type AuditEvent struct {
+	Who  string
+	What string
+	When time.Time
+}
+
+// AuditSink receives an AuditEvent for every change made through an AuditingStore
+type AuditSink func(AuditEvent)
+
+// AuditingStore wraps a ManagedPlayerStore, sending an AuditEvent to a sink for
+// every change made to it. Reads are passed straight through without being audited.
+//
+// The optional store interfaces the server looks for are passed through too, so
+// wrapping a store doesn't turn off any of its endpoints. Those which can be
+// built from a ManagedPlayerStore's methods work even if the wrapped store
+// doesn't implement them, such as a loss becoming a point taken away like the
+// server itself would do
+type AuditingStore struct {
+	ManagedPlayerStore
+	sink  AuditSink
+	clock clock.Clock
+}
+
+// lastWinAuditingStore is an AuditingStore of a store which knows when players
+// last won, which is the one thing an AuditingStore can't work out for itself
+type lastWinAuditingStore struct {
+	*AuditingStore
+	LastWinStore
+}
+
+// NewAuditingStore creates an AuditingStore which uses clock to timestamp
+// events. If store is a LastWinStore then so is the store returned
+func NewAuditingStore(store ManagedPlayerStore, sink AuditSink, clock clock.Clock) ManagedPlayerStore {
+	auditing := &AuditingStore{
+		ManagedPlayerStore: store,
+		sink:               sink,
+		clock:              clock,
+	}
+
+	if lastWins, ok := store.(LastWinStore); ok {
+		return &lastWinAuditingStore{auditing, lastWins}
+	}
+
+	return auditing
+}
+
+// RecordWin records a win in the underlying store and audits it
+func (a *AuditingStore) RecordWin(name string) {
+	a.ManagedPlayerStore.RecordWin(name)
+	a.audit(name, "record win")
+}
+
+// RecordLoss records a loss in the underlying store, or takes a point away if
+// it doesn't record losses, and audits it
+func (a *AuditingStore) RecordLoss(name string) {
+	if store, ok := a.ManagedPlayerStore.(LossStore); ok {
+		store.RecordLoss(name)
+	} else {
+		a.ManagedPlayerStore.AddPoints(name, -1)
+	}
+	a.audit(name, "record loss")
+}
+
+// SetScores sets the players' scores in the underlying store, adding the
+// difference to each player's score if it can't set them, and audits each one
+func (a *AuditingStore) SetScores(players []Player) {
+	if store, ok := a.ManagedPlayerStore.(BulkScoreStore); ok {
+		store.SetScores(players)
+	} else {
+		for _, player := range players {
+			a.ManagedPlayerStore.AddPoints(player.Name, player.Wins-a.ManagedPlayerStore.GetPlayerScore(player.Name))
+		}
+	}
+
+	for _, player := range players {
+		a.audit(player.Name, fmt.Sprintf("set score to %d", player.Wins))
+	}
+}
+
+// PlayersInRange returns the players in the underlying store with between min and max wins inclusive
+func (a *AuditingStore) PlayersInRange(min, max int) []Player {
+	if store, ok := a.ManagedPlayerStore.(RangeStore); ok {
+		return store.PlayersInRange(min, max)
+	}
+	return a.ManagedPlayerStore.GetLeague().InRange(min, max)
+}
+
+// TotalWins adds up the wins of every player in the underlying store
+func (a *AuditingStore) TotalWins() int {
+	if store, ok := a.ManagedPlayerStore.(TotalWinsStore); ok {
+		return store.TotalWins()
+	}
+
+	total := 0
+	for _, player := range a.ManagedPlayerStore.GetLeague() {
+		total += player.Wins
+	}
+	return total
+}
+
+// LoadLeague returns the underlying store's league, or the error from reading it
+func (a *AuditingStore) LoadLeague() (League, error) {
+	return loadLeague(a.ManagedPlayerStore)
+}
+
+// AddPoints adds points in the underlying store and audits it
+func (a *AuditingStore) AddPoints(name string, points int) {
+	a.ManagedPlayerStore.AddPoints(name, points)
+	a.audit(name, fmt.Sprintf("add %d points", points))
+}
+
+// RemovePlayer removes a player from the underlying store and audits it
+func (a *AuditingStore) RemovePlayer(name string) {
+	a.ManagedPlayerStore.RemovePlayer(name)
+	a.audit(name, "remove player")
+}
+
+// Rename renames a player in the underlying store, auditing it if it succeeds
+func (a *AuditingStore) Rename(from, to string) error {
+	if err := a.ManagedPlayerStore.Rename(from, to); err != nil {
+		return err
+	}
+
+	a.audit(from, fmt.Sprintf("rename to %s", to))
+	return nil
+}
+
+func (a *AuditingStore) audit(who, what string) {
+	a.sink(AuditEvent{Who: who, What: what, When: a.clock.Now()})
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/quii/learn-go-with-tests/internal/clock"
+)
+
+func TestAuditingStore(t *testing.T) {
+	clock := clock.NewFakeClock(time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC))
+	var events []AuditEvent
+	sink := func(e AuditEvent) { events = append(events, e) }
+
+	store := NewAuditingStore(NewInMemoryPlayerStore(), sink, clock)
+
+	store.RecordWin("Cleo")
+	clock.Advance(time.Minute)
+	store.AddPoints("Cleo", 5)
+	clock.Advance(time.Minute)
+	store.Rename("Cleo", "Chris")
+	clock.Advance(time.Minute)
+	store.RemovePlayer("Chris")
+
+	// reads and failed changes are not audited
+	store.GetPlayerScore("Chris")
+	store.GetLeague()
+	store.Rename("Cleo", "Pepper")
+
+	start := time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC)
+	want := []AuditEvent{
+		{Who: "Cleo", What: "record win", When: start},
+		{Who: "Cleo", What: "add 5 points", When: start.Add(time.Minute)},
+		{Who: "Cleo", What: "rename to Chris", When: start.Add(2 * time.Minute)},
+		{Who: "Chris", What: "remove player", When: start.Add(3 * time.Minute)},
+	}
+
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("got events %+v want %+v", events, want)
+	}
+}
+
+func TestAuditingStoreOptionalInterfaces(t *testing.T) {
+	start := time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("losses and set scores are audited", func(t *testing.T) {
+		var events []AuditEvent
+		sink := func(e AuditEvent) { events = append(events, e) }
+		inMemory := NewInMemoryPlayerStore()
+
+		store := NewAuditingStore(inMemory, sink, clock.NewFakeClock(start))
+
+		store.RecordWin("Cleo")
+		store.(LossStore).RecordLoss("Cleo")
+		store.(BulkScoreStore).SetScores([]Player{{"Chris", 3}})
+
+		want := []AuditEvent{
+			{Who: "Cleo", What: "record win", When: start},
+			{Who: "Cleo", What: "record loss", When: start},
+			{Who: "Chris", What: "set score to 3", When: start},
+		}
+
+		if !reflect.DeepEqual(events, want) {
+			t.Errorf("got events %+v want %+v", events, want)
+		}
+
+		// the loss went to the in memory store, so it ended the first streak
+		inMemory.RecordWin("Cleo")
+		assertScoreEquals(t, inMemory.CurrentStreak("Cleo"), 1)
+	})
+
+	t.Run("stores without the optional interfaces fall back to the managed ones", func(t *testing.T) {
+		database, cleanDatabase := createTempFile(t, `[{"Name": "Cleo", "Wins": 10}]`)
+		defer cleanDatabase()
+
+		fileStore, err := NewFileSystemPlayerStore(database)
+		assertNoError(t, err)
+
+		store := NewAuditingStore(fileStore, func(AuditEvent) {}, clock.NewFakeClock(start))
+
+		store.(LossStore).RecordLoss("Cleo")
+		assertScoreEquals(t, store.GetPlayerScore("Cleo"), 9)
+
+		if _, ok := store.(LastWinStore); ok {
+			t.Error("did not expect a store which doesn't know when players last won to be a LastWinStore")
+		}
+	})
+
+	t.Run("the server's optional endpoints work through the wrapper", func(t *testing.T) {
+		clock := clock.NewFakeClock(start)
+		inMemory := NewInMemoryPlayerStoreWithClock(clock)
+		store := NewAuditingStore(inMemory, func(AuditEvent) {}, clock)
+		server := NewPlayerServer(store, WithClock(clock))
+
+		store.AddPoints("Cleo", 32)
+		clock.Advance(48 * time.Hour)
+		store.AddPoints("Chris", 20)
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newLeagueTotalRequest())
+		assertStatus(t, response.Code, http.StatusOK)
+		assertResponseBody(t, response.Body.String(), "{\"total\":52}\n")
+
+		response = httptest.NewRecorder()
+		server.ServeHTTP(response, newLeagueRangeRequest("30", ""))
+		assertStatus(t, response.Code, http.StatusOK)
+		assertLeague(t, getLeagueFromResponse(t, response.Body), []Player{{"Cleo", 32}})
+
+		response = httptest.NewRecorder()
+		server.ServeHTTP(response, newInactiveRequest("1"))
+		assertStatus(t, response.Code, http.StatusOK)
+		assertLeague(t, getLeagueFromResponse(t, response.Body), []Player{{"Cleo", 32}})
+
+		store.RecordWin("Chris")
+		response = httptest.NewRecorder()
+		server.ServeHTTP(response, newPostLossRequest("Chris"))
+		assertStatus(t, response.Code, http.StatusAccepted)
+
+		inMemory.RecordWin("Chris")
+		assertScoreEquals(t, inMemory.CurrentStreak("Chris"), 1)
+	})
+}
@@ -4,12 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"sort"
 )
 
 // FileSystemPlayerStore stores players in the filesystem
 type FileSystemPlayerStore struct {
 	database *json.Encoder
+	file     *os.File
 	league   League
 }
 
@@ -30,6 +30,7 @@ func NewFileSystemPlayerStore(file *os.File) (*FileSystemPlayerStore, error) {
 
 	return &FileSystemPlayerStore{
 		database: json.NewEncoder(&tape{file}),
+		file:     file,
 		league:   league,
 	}, nil
 }
@@ -51,14 +52,26 @@ func initialisePlayerDBFile(file *os.File) error {
 	return nil
 }
 
-// GetLeague returns the scores of all the players
+// GetLeague returns the scores of all the players, most wins first and then by name
 func (f *FileSystemPlayerStore) GetLeague() League {
-	sort.Slice(f.league, func(i, j int) bool {
-		return f.league[i].Wins > f.league[j].Wins
-	})
+	f.league.Sort()
 	return f.league
 }
 
+// PlayersInRange returns the players with between min and max wins inclusive, most wins first
+func (f *FileSystemPlayerStore) PlayersInRange(min, max int) []Player {
+	return f.league.InRange(min, max)
+}
+
+// TotalWins adds up the wins of every player
+func (f *FileSystemPlayerStore) TotalWins() int {
+	total := 0
+	for _, player := range f.league {
+		total += player.Wins
+	}
+	return total
+}
+
 // GetPlayerScore retrieves a player's score
 func (f *FileSystemPlayerStore) GetPlayerScore(name string) int {
 
@@ -83,3 +96,73 @@ func (f *FileSystemPlayerStore) RecordWin(name string) {
 
 	f.database.Encode(f.league)
 }
+
+// SetScores sets the wins of each of the players, adding any not already in
+// the store, and saves them all in one write
+func (f *FileSystemPlayerStore) SetScores(players []Player) {
+	for _, p := range players {
+		player := f.league.Find(p.Name)
+
+		if player != nil {
+			player.Wins = p.Wins
+		} else {
+			f.league = append(f.league, p)
+		}
+	}
+
+	f.database.Encode(f.league)
+}
+
+// AddPoints changes a player's score by points, which can be negative, without going below zero
+func (f *FileSystemPlayerStore) AddPoints(name string, points int) {
+	player := f.league.Find(name)
+
+	if player == nil {
+		f.league = append(f.league, Player{name, 0})
+		player = &f.league[len(f.league)-1]
+	}
+
+	player.Wins += points
+	if player.Wins < 0 {
+		player.Wins = 0
+	}
+
+	f.database.Encode(f.league)
+}
+
+// RemovePlayer deletes a player from the store
+func (f *FileSystemPlayerStore) RemovePlayer(name string) {
+	for i, player := range f.league {
+		if player.Name == name {
+			f.league = append(f.league[:i], f.league[i+1:]...)
+			f.database.Encode(f.league)
+			return
+		}
+	}
+}
+
+// Rename changes a player's name, keeping their score
+func (f *FileSystemPlayerStore) Rename(from, to string) error {
+	player := f.league.Find(from)
+
+	if player == nil {
+		return ErrPlayerNotFound
+	}
+
+	if f.league.Find(to) != nil {
+		return ErrPlayerExists
+	}
+
+	player.Name = to
+	f.database.Encode(f.league)
+
+	return nil
+}
+
+// Ping checks the database file can still be reached
+func (f *FileSystemPlayerStore) Ping() error {
+	if _, err := f.file.Stat(); err != nil {
+		return fmt.Errorf("problem reaching player db file %s, %v", f.file.Name(), err)
+	}
+	return nil
+}
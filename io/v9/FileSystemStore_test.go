@@ -51,6 +51,25 @@ func TestFileSystemStore(t *testing.T) {
 		assertLeague(t, got, want)
 	})
 
+	t.Run("players with the same wins are sorted by name", func(t *testing.T) {
+		database, cleanDatabase := createTempFile(t, "")
+		defer cleanDatabase()
+
+		store, err := NewFileSystemPlayerStore(database)
+		assertNoError(t, err)
+
+		store.RecordWin("Pepper")
+		store.RecordWin("Cleo")
+		store.RecordWin("Chris")
+		store.RecordWin("Chris")
+
+		assertStableLeague(t, store, []Player{
+			{"Chris", 2},
+			{"Cleo", 1},
+			{"Pepper", 1},
+		})
+	})
+
 	t.Run("get player score", func(t *testing.T) {
 		database, cleanDatabase := createTempFile(t, `[
 			{"Name": "Cleo", "Wins": 10},
@@ -100,6 +119,89 @@ func TestFileSystemStore(t *testing.T) {
 		assertScoreEquals(t, got, want)
 	})
 
+	t.Run("total wins", func(t *testing.T) {
+		database, cleanDatabase := createTempFile(t, `[
+			{"Name": "Cleo", "Wins": 10},
+			{"Name": "Chris", "Wins": 33}]`)
+		defer cleanDatabase()
+
+		store, err := NewFileSystemPlayerStore(database)
+
+		assertNoError(t, err)
+		assertScoreEquals(t, store.TotalWins(), 43)
+	})
+
+	t.Run("add points", func(t *testing.T) {
+		database, cleanDatabase := createTempFile(t, `[
+			{"Name": "Cleo", "Wins": 10},
+			{"Name": "Chris", "Wins": 33}]`)
+		defer cleanDatabase()
+
+		store, err := NewFileSystemPlayerStore(database)
+
+		assertNoError(t, err)
+
+		store.AddPoints("Chris", 7)
+		store.AddPoints("Cleo", -20)
+		store.AddPoints("Pepper", 2)
+
+		assertScoreEquals(t, store.GetPlayerScore("Chris"), 40)
+		assertScoreEquals(t, store.GetPlayerScore("Cleo"), 0)
+		assertScoreEquals(t, store.GetPlayerScore("Pepper"), 2)
+	})
+
+	t.Run("remove and rename players", func(t *testing.T) {
+		database, cleanDatabase := createTempFile(t, `[
+			{"Name": "Cleo", "Wins": 10},
+			{"Name": "Chris", "Wins": 33}]`)
+		defer cleanDatabase()
+
+		store, err := NewFileSystemPlayerStore(database)
+
+		assertNoError(t, err)
+
+		store.RemovePlayer("Cleo")
+		assertNoError(t, store.Rename("Chris", "Pepper"))
+
+		if err := store.Rename("Cleo", "Tiest"); err != ErrPlayerNotFound {
+			t.Errorf("got error %v want %v", err, ErrPlayerNotFound)
+		}
+
+		// reload from the file to check the changes were saved
+		reloaded, err := NewFileSystemPlayerStore(database)
+
+		assertNoError(t, err)
+		assertLeague(t, reloaded.GetLeague(), []Player{{"Pepper", 33}})
+	})
+
+	t.Run("ping fails once the file is closed", func(t *testing.T) {
+		database, cleanDatabase := createTempFile(t, `[]`)
+		defer cleanDatabase()
+
+		store, err := NewFileSystemPlayerStore(database)
+
+		assertNoError(t, err)
+		assertNoError(t, store.Ping())
+
+		database.Close()
+
+		if store.Ping() == nil {
+			t.Error("expected an error pinging a closed file")
+		}
+	})
+
+	t.Run("rejects a file with invalid players", func(t *testing.T) {
+		database, cleanDatabase := createTempFile(t, `[
+			{"Name": "Cleo", "Wins": -10}]`)
+		defer cleanDatabase()
+
+		_, err := NewFileSystemPlayerStore(database)
+
+		if err == nil {
+			t.Error("expected an error loading a player with negative wins")
+		}
+	})
+
 	t.Run("works with an empty file", func(t *testing.T) {
 		database, cleanDatabase := createTempFile(t, "")
 		defer cleanDatabase()
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"github.com/quii/learn-go-with-tests/internal/clock"
+)
+
+// NewInMemoryPlayerStore initialises an empty player store
+func NewInMemoryPlayerStore() *InMemoryPlayerStore {
+	return NewInMemoryPlayerStoreWithClock(clock.RealClock{})
+}
+
+// NewInMemoryPlayerStoreWithClock initialises an empty player store which uses clock to timestamp wins
+func NewInMemoryPlayerStoreWithClock(clock clock.Clock) *InMemoryPlayerStore {
+	return &InMemoryPlayerStore{
+		store:   map[string]int{},
+		lastWin: map[string]time.Time{},
+		history: map[string][]bool{},
+		clock:   clock,
+	}
+}
+
+// InMemoryPlayerStore collects data about players in memory
+type InMemoryPlayerStore struct {
+	mu      sync.RWMutex
+	store   map[string]int
+	lastWin map[string]time.Time
+	// history is each player's wins (true) and losses (false) in the order they were recorded
+	history map[string][]bool
+	clock   clock.Clock
+}
+
+// GetLeague returns a collection of Players, most wins first and then by name
+func (i *InMemoryPlayerStore) GetLeague() League {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var league League
+	for name, wins := range i.store {
+		league = append(league, Player{name, wins})
+	}
+	league.Sort()
+	return league
+}
+
+// PlayersInRange returns the players with between min and max wins inclusive, most wins first
+func (i *InMemoryPlayerStore) PlayersInRange(min, max int) []Player {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var league League
+	for name, wins := range i.store {
+		league = append(league, Player{name, wins})
+	}
+	return league.InRange(min, max)
+}
+
+// TotalWins adds up the wins of every player
+func (i *InMemoryPlayerStore) TotalWins() int {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	total := 0
+	for _, wins := range i.store {
+		total += wins
+	}
+	return total
+}
+
+// RecordWin will record a player's win
+func (i *InMemoryPlayerStore) RecordWin(name string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.store[name]++
+	i.lastWin[name] = i.clock.Now()
+	i.history[name] = append(i.history[name], true)
+}
+
+// RecordLoss will record a player's loss, taking a point off their score without going below zero
+func (i *InMemoryPlayerStore) RecordLoss(name string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.store[name] > 0 {
+		i.store[name]--
+	} else {
+		i.store[name] = 0
+	}
+	i.history[name] = append(i.history[name], false)
+}
+
+// CurrentStreak returns how many wins a player has recorded in a row since their last loss
+func (i *InMemoryPlayerStore) CurrentStreak(name string) int {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	history := i.history[name]
+	streak := 0
+	for j := len(history) - 1; j >= 0 && history[j]; j-- {
+		streak++
+	}
+	return streak
+}
+
+// SetScores sets the wins of each of the players, adding any not already in the
+// store. Players given any wins count as having won now, so freshly seeded
+// players are not treated as idle
+func (i *InMemoryPlayerStore) SetScores(players []Player) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	now := i.clock.Now()
+	for _, player := range players {
+		i.store[player.Name] = player.Wins
+		if player.Wins > 0 {
+			i.lastWin[player.Name] = now
+		}
+	}
+}
+
+// AddPoints changes a player's score by points, which can be negative, without going below zero
+func (i *InMemoryPlayerStore) AddPoints(name string, points int) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.store[name] += points
+	if i.store[name] < 0 {
+		i.store[name] = 0
+	}
+
+	if points > 0 {
+		i.lastWin[name] = i.clock.Now()
+	}
+}
+
+// RemovePlayer deletes a player from the store
+func (i *InMemoryPlayerStore) RemovePlayer(name string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.store, name)
+	delete(i.lastWin, name)
+	delete(i.history, name)
+}
+
+// Rename changes a player's name, keeping their score
+func (i *InMemoryPlayerStore) Rename(from, to string) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	wins, ok := i.store[from]
+	if !ok {
+		return ErrPlayerNotFound
+	}
+
+	if _, exists := i.store[to]; exists {
+		return ErrPlayerExists
+	}
+
+	i.store[to] = wins
+	delete(i.store, from)
+
+	if last, ok := i.lastWin[from]; ok {
+		i.lastWin[to] = last
+		delete(i.lastWin, from)
+	}
+
+	if history, ok := i.history[from]; ok {
+		i.history[to] = history
+		delete(i.history, from)
+	}
+
+	return nil
+}
+
+// GetPlayerScore retrieves scores for a given player
+func (i *InMemoryPlayerStore) GetPlayerScore(name string) int {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.store[name]
+}
+
+// Ping always succeeds as the players are held in memory
+func (i *InMemoryPlayerStore) Ping() error {
+	return nil
+}
+
+// LastWin returns when a player last recorded a win, if they ever have
+func (i *InMemoryPlayerStore) LastWin(name string) (time.Time, bool) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	last, ok := i.lastWin[name]
+	return last, ok
+}
+
+// EvictIdle removes players who have not recorded a win within ttl, including
+// players who have never won, returning how many were removed
+func (i *InMemoryPlayerStore) EvictIdle(ttl time.Duration) int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	cutoff := i.clock.Now().Add(-ttl)
+	evicted := 0
+
+	for name := range i.store {
+		if last, ok := i.lastWin[name]; !ok || last.Before(cutoff) {
+			delete(i.store, name)
+			delete(i.lastWin, name)
+			delete(i.history, name)
+			evicted++
+		}
+	}
+
+	return evicted
+}
+
+// DecayInactive takes penalty points off every player who has not recorded a
+// win within threshold, including players who have never won, without going
+// below zero, returning how many players lost points. A negative penalty is
+// treated as zero, so decaying can never raise a score. Each call applies the
+// penalty again, so it is meant to be run once per period of a season
+func (i *InMemoryPlayerStore) DecayInactive(threshold time.Duration, penalty int) int {
+	if penalty <= 0 {
+		return 0
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	cutoff := i.clock.Now().Add(-threshold)
+	penalised := 0
+
+	for name, score := range i.store {
+		if last, ok := i.lastWin[name]; (ok && !last.Before(cutoff)) || score == 0 {
+			continue
+		}
+
+		i.store[name] -= penalty
+		if i.store[name] < 0 {
+			i.store[name] = 0
+		}
+		penalised++
+	}
+
+	return penalised
+}
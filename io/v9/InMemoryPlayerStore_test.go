@@ -0,0 +1,321 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/quii/learn-go-with-tests/internal/clock"
+	"github.com/quii/learn-go-with-tests/internal/collections"
+)
+
+func TestInMemoryPlayerStoreLastWin(t *testing.T) {
+	start := time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC)
+	clock := clock.NewFakeClock(start)
+	store := NewInMemoryPlayerStoreWithClock(clock)
+
+	store.RecordWin("Cleo")
+	clock.Advance(time.Hour)
+	store.RecordWin("Chris")
+
+	assertLastWin(t, store, "Cleo", start)
+	assertLastWin(t, store, "Chris", start.Add(time.Hour))
+
+	if _, ok := store.LastWin("Pepper"); ok {
+		t.Error("did not expect a last win for a player who never won")
+	}
+}
+
+func assertLastWin(t *testing.T, store LastWinStore, name string, want time.Time) {
+	t.Helper()
+
+	got, ok := store.LastWin(name)
+	if !ok {
+		t.Fatalf("expected a last win for %s", name)
+	}
+
+	if !got.Equal(want) {
+		t.Errorf("got last win %v for %s want %v", got, name, want)
+	}
+}
+
+func TestInMemoryPlayerStoreEvictIdle(t *testing.T) {
+	t.Run("evicts players whose last win is older than the ttl", func(t *testing.T) {
+		clock := clock.NewFakeClock(time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC))
+		store := NewInMemoryPlayerStoreWithClock(clock)
+
+		store.RecordWin("Cleo")
+		clock.Advance(30 * time.Minute)
+		store.RecordWin("Chris")
+		clock.Advance(45 * time.Minute)
+
+		evicted := store.EvictIdle(time.Hour)
+
+		if evicted != 1 {
+			t.Errorf("got %d players evicted want 1", evicted)
+		}
+
+		assertScoreEquals(t, store.GetPlayerScore("Cleo"), 0)
+		assertScoreEquals(t, store.GetPlayerScore("Chris"), 1)
+		assertLeague(t, store.GetLeague(), []Player{{"Chris", 1}})
+	})
+
+	t.Run("keeps players who won within the ttl", func(t *testing.T) {
+		clock := clock.NewFakeClock(time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC))
+		store := NewInMemoryPlayerStoreWithClock(clock)
+
+		store.RecordWin("Cleo")
+		clock.Advance(time.Hour)
+
+		evicted := store.EvictIdle(time.Hour)
+
+		if evicted != 0 {
+			t.Errorf("got %d players evicted want 0", evicted)
+		}
+
+		assertLeague(t, store.GetLeague(), []Player{{"Cleo", 1}})
+	})
+
+	t.Run("evicts players who have never won", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+		store.SetScores([]Player{{"Pepper", 0}})
+		store.RecordLoss("Tiest")
+		store.RecordWin("Cleo")
+
+		evicted := store.EvictIdle(time.Hour)
+
+		if evicted != 2 {
+			t.Errorf("got %d players evicted want 2", evicted)
+		}
+
+		assertLeagueContains(t, store.GetLeague(), Player{"Cleo", 1})
+		assertLeagueMissing(t, store.GetLeague(), Player{"Pepper", 0})
+		assertLeagueMissing(t, store.GetLeague(), Player{"Tiest", 0})
+	})
+
+	t.Run("seeded players with wins are not idle until the ttl has passed", func(t *testing.T) {
+		clock := clock.NewFakeClock(time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC))
+		store := NewInMemoryPlayerStoreWithClock(clock)
+		assertNoError(t, SeedPlayers(store, []Player{{"Cleo", 32}, {"Chris", 20}}))
+
+		if evicted := store.EvictIdle(time.Hour); evicted != 0 {
+			t.Errorf("got %d players evicted straight after seeding want 0", evicted)
+		}
+		assertLeague(t, store.GetLeague(), []Player{{"Cleo", 32}, {"Chris", 20}})
+
+		clock.Advance(2 * time.Hour)
+
+		if evicted := store.EvictIdle(time.Hour); evicted != 2 {
+			t.Errorf("got %d players evicted after the ttl want 2", evicted)
+		}
+	})
+}
+
+func TestInMemoryPlayerStoreDecayInactive(t *testing.T) {
+	t.Run("penalises players without a recent win", func(t *testing.T) {
+		clock := clock.NewFakeClock(time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC))
+		store := NewInMemoryPlayerStoreWithClock(clock)
+
+		store.AddPoints("Cleo", 10)
+		store.AddPoints("Pepper", 2)
+		clock.Advance(48 * time.Hour)
+		store.AddPoints("Chris", 10)
+		clock.Advance(time.Hour)
+
+		penalised := store.DecayInactive(24*time.Hour, 5)
+
+		assertScoreEquals(t, penalised, 2)
+		assertScoreEquals(t, store.GetPlayerScore("Cleo"), 5)
+		assertScoreEquals(t, store.GetPlayerScore("Pepper"), 0)
+		assertScoreEquals(t, store.GetPlayerScore("Chris"), 10)
+
+		// players already at zero have nothing left to lose
+		penalised = store.DecayInactive(24*time.Hour, 5)
+
+		assertScoreEquals(t, penalised, 1)
+		assertScoreEquals(t, store.GetPlayerScore("Cleo"), 0)
+	})
+
+	t.Run("seeded players count as winning when they were seeded", func(t *testing.T) {
+		clock := clock.NewFakeClock(time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC))
+		store := NewInMemoryPlayerStoreWithClock(clock)
+		store.SetScores([]Player{{"Cleo", 10}})
+
+		penalised := store.DecayInactive(24*time.Hour, 5)
+		assertScoreEquals(t, penalised, 0)
+
+		clock.Advance(48 * time.Hour)
+
+		penalised = store.DecayInactive(24*time.Hour, 5)
+		assertScoreEquals(t, penalised, 1)
+		assertScoreEquals(t, store.GetPlayerScore("Cleo"), 5)
+	})
+
+	t.Run("a negative penalty is treated as zero", func(t *testing.T) {
+		clock := clock.NewFakeClock(time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC))
+		store := NewInMemoryPlayerStoreWithClock(clock)
+		store.SetScores([]Player{{"Cleo", 10}})
+		clock.Advance(48 * time.Hour)
+
+		penalised := store.DecayInactive(24*time.Hour, -5)
+
+		assertScoreEquals(t, penalised, 0)
+		assertScoreEquals(t, store.GetPlayerScore("Cleo"), 10)
+	})
+}
+
+func TestInMemoryPlayerStoreAddPoints(t *testing.T) {
+	t.Run("adds positive points", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+		store.RecordWin("Cleo")
+
+		store.AddPoints("Cleo", 5)
+
+		assertScoreEquals(t, store.GetPlayerScore("Cleo"), 6)
+	})
+
+	t.Run("a penalty cannot take a score below zero", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+		store.AddPoints("Cleo", 3)
+
+		store.AddPoints("Cleo", -5)
+
+		assertScoreEquals(t, store.GetPlayerScore("Cleo"), 0)
+	})
+
+	t.Run("creates new players", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+
+		store.AddPoints("Chris", 3)
+		store.AddPoints("Pepper", -3)
+
+		assertLeague(t, store.GetLeague(), []Player{{"Chris", 3}, {"Pepper", 0}})
+	})
+}
+
+func TestInMemoryPlayerStoreLeague(t *testing.T) {
+	t.Run("most wins first", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+
+		store.RecordWin("Cleo")
+		store.RecordWin("Chris")
+		store.RecordWin("Chris")
+
+		assertLeague(t, store.GetLeague(), []Player{{"Chris", 2}, {"Cleo", 1}})
+	})
+
+	t.Run("players with the same wins are sorted by name", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+
+		store.RecordWin("Pepper")
+		store.RecordWin("Cleo")
+		store.RecordWin("Chris")
+		store.RecordWin("Chris")
+
+		assertStableLeague(t, store, []Player{
+			{"Chris", 2},
+			{"Cleo", 1},
+			{"Pepper", 1},
+		})
+	})
+}
+
+func TestInMemoryPlayerStoreTotalWins(t *testing.T) {
+	t.Run("sums every player's wins", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+		store.AddPoints("Cleo", 10)
+		store.AddPoints("Chris", 33)
+		store.RecordWin("Pepper")
+
+		assertScoreEquals(t, store.TotalWins(), 44)
+	})
+
+	t.Run("an empty league has no wins", func(t *testing.T) {
+		assertScoreEquals(t, NewInMemoryPlayerStore().TotalWins(), 0)
+	})
+}
+
+func TestInMemoryPlayerStoreStreaks(t *testing.T) {
+	t.Run("counts wins since the last loss", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+		store.RecordWin("Cleo")
+		store.RecordLoss("Cleo")
+		store.RecordWin("Cleo")
+		store.RecordWin("Cleo")
+
+		assertScoreEquals(t, store.CurrentStreak("Cleo"), 2)
+		assertScoreEquals(t, store.GetPlayerScore("Cleo"), 2)
+	})
+
+	t.Run("a recent loss ends the streak", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+		store.RecordWin("Cleo")
+		store.RecordWin("Cleo")
+		store.RecordLoss("Cleo")
+
+		assertScoreEquals(t, store.CurrentStreak("Cleo"), 0)
+	})
+
+	t.Run("a loss cannot take a score below zero", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+		store.RecordLoss("Cleo")
+
+		assertScoreEquals(t, store.GetPlayerScore("Cleo"), 0)
+	})
+
+	t.Run("players without a history have no streak", func(t *testing.T) {
+		assertScoreEquals(t, NewInMemoryPlayerStore().CurrentStreak("Cleo"), 0)
+	})
+}
+
+func TestInMemoryPlayerStoreManagement(t *testing.T) {
+	t.Run("remove a player", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+		store.RecordWin("Cleo")
+		store.RecordWin("Chris")
+
+		store.RemovePlayer("Cleo")
+
+		assertLeague(t, store.GetLeague(), []Player{{"Chris", 1}})
+	})
+
+	t.Run("rename a player", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+		store.RecordWin("Cleo")
+
+		err := store.Rename("Cleo", "Chris")
+
+		assertNoError(t, err)
+		assertLeague(t, store.GetLeague(), []Player{{"Chris", 1}})
+		if _, ok := store.LastWin("Chris"); !ok {
+			t.Error("expected the last win to move with the player")
+		}
+	})
+
+	t.Run("cannot rename a missing player or onto an existing one", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+		store.RecordWin("Cleo")
+		store.RecordWin("Chris")
+
+		if err := store.Rename("Pepper", "Tiest"); err != ErrPlayerNotFound {
+			t.Errorf("got error %v want %v", err, ErrPlayerNotFound)
+		}
+
+		if err := store.Rename("Cleo", "Chris"); err != ErrPlayerExists {
+			t.Errorf("got error %v want %v", err, ErrPlayerExists)
+		}
+	})
+}
+
+func assertLeagueContains(t *testing.T, league League, want Player) {
+	t.Helper()
+	if !collections.Contains(league, want) {
+		t.Errorf("expected %v in league %v", want, league)
+	}
+}
+
+func assertLeagueMissing(t *testing.T, league League, unwanted Player) {
+	t.Helper()
+	if collections.Contains(league, unwanted) {
+		t.Errorf("did not expect %v in league %v", unwanted, league)
+	}
+}
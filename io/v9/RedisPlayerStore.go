@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// RedisPlayerStore stores players in a redis sorted set, scored by their wins,
+// so every server sharing the redis sees the same league
+type RedisPlayerStore struct {
+	client    redis.Cmdable
+	leagueKey string
+}
+
+// NewRedisPlayerStore creates a RedisPlayerStore which keeps the league in the sorted set at leagueKey
+func NewRedisPlayerStore(client redis.Cmdable, leagueKey string) *RedisPlayerStore {
+	return &RedisPlayerStore{client, leagueKey}
+}
+
+// GetLeague returns the scores of all the players, highest first and then by name
+func (r *RedisPlayerStore) GetLeague() League {
+	league, err := r.LoadLeague()
+
+	if err != nil {
+		log.Print(err)
+	}
+
+	return league
+}
+
+// LoadLeague returns the scores of all the players like GetLeague, or the error from reading them
+func (r *RedisPlayerStore) LoadLeague() (League, error) {
+	scores, err := r.client.ZRevRangeWithScores(context.Background(), r.leagueKey, 0, -1).Result()
+
+	if err != nil {
+		return nil, fmt.Errorf("problem getting league, %v", err)
+	}
+
+	var league League
+	for _, score := range scores {
+		name, _ := score.Member.(string)
+		league = append(league, Player{name, int(score.Score)})
+	}
+
+	// redis breaks ties in reverse name order, so put those back the other way round
+	league.Sort()
+
+	return league, nil
+}
+
+// GetPlayerScore retrieves a player's score
+func (r *RedisPlayerStore) GetPlayerScore(name string) int {
+	wins, err := r.client.ZScore(context.Background(), r.leagueKey, name).Result()
+
+	if err != nil && err != redis.Nil {
+		log.Printf("problem getting score for %s, %v", name, err)
+	}
+
+	return int(wins)
+}
+
+// RecordWin will store a win for a player, incrementing wins if already known
+func (r *RedisPlayerStore) RecordWin(name string) {
+	if err := r.client.ZIncrBy(context.Background(), r.leagueKey, 1, name).Err(); err != nil {
+		log.Printf("problem recording win for %s, %v", name, err)
+	}
+}
+
+// Ping checks redis can still be reached
+func (r *RedisPlayerStore) Ping() error {
+	return r.client.Ping(context.Background()).Err()
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+)
+
+func createRedisPlayerStore(t *testing.T) (*RedisPlayerStore, *miniredis.Miniredis) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return NewRedisPlayerStore(client, "league"), server
+}
+
+func TestRedisPlayerStore(t *testing.T) {
+
+	t.Run("league sorted", func(t *testing.T) {
+		store, _ := createRedisPlayerStore(t)
+
+		store.RecordWin("Cleo")
+		store.RecordWin("Chris")
+		store.RecordWin("Chris")
+
+		want := []Player{
+			{"Chris", 2},
+			{"Cleo", 1},
+		}
+
+		assertLeague(t, store.GetLeague(), want)
+	})
+
+	t.Run("players with the same wins are sorted by name", func(t *testing.T) {
+		store, _ := createRedisPlayerStore(t)
+
+		store.RecordWin("Pepper")
+		store.RecordWin("Cleo")
+		store.RecordWin("Chris")
+		store.RecordWin("Chris")
+
+		assertStableLeague(t, store, []Player{
+			{"Chris", 2},
+			{"Cleo", 1},
+			{"Pepper", 1},
+		})
+	})
+
+	t.Run("get player score", func(t *testing.T) {
+		store, _ := createRedisPlayerStore(t)
+
+		store.RecordWin("Chris")
+		store.RecordWin("Chris")
+
+		assertScoreEquals(t, store.GetPlayerScore("Chris"), 2)
+	})
+
+	t.Run("unknown players have no wins", func(t *testing.T) {
+		store, _ := createRedisPlayerStore(t)
+
+		assertScoreEquals(t, store.GetPlayerScore("Pepper"), 0)
+	})
+
+	t.Run("wins are kept in the sorted set at the injected key", func(t *testing.T) {
+		store, server := createRedisPlayerStore(t)
+
+		store.RecordWin("Pepper")
+
+		wins, err := server.ZScore("league", "Pepper")
+		assertNoError(t, err)
+
+		if wins != 1 {
+			t.Errorf("got %v wins in redis want 1", wins)
+		}
+	})
+
+	t.Run("ping", func(t *testing.T) {
+		store, server := createRedisPlayerStore(t)
+
+		assertNoError(t, store.Ping())
+
+		server.Close()
+
+		if err := store.Ping(); err == nil {
+			t.Error("expected an error pinging a stopped redis")
+		}
+	})
+}
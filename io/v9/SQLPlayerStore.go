@@ -0,0 +1,189 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+)
+
+const sqlPlayerSchema = `CREATE TABLE IF NOT EXISTS players (
+	name TEXT PRIMARY KEY,
+	wins INTEGER NOT NULL DEFAULT 0
+)`
+
+// SQLPlayerStore stores players in an SQL database
+type SQLPlayerStore struct {
+	db *sql.DB
+}
+
+// NewSQLPlayerStore creates a SQLPlayerStore, creating the players table if needed
+func NewSQLPlayerStore(db *sql.DB) (*SQLPlayerStore, error) {
+	if _, err := db.Exec(sqlPlayerSchema); err != nil {
+		return nil, fmt.Errorf("problem creating players table, %v", err)
+	}
+
+	return &SQLPlayerStore{db}, nil
+}
+
+// GetLeague returns the scores of all the players, highest first and then by name
+func (s *SQLPlayerStore) GetLeague() League {
+	league, err := s.LoadLeague()
+
+	if err != nil {
+		log.Print(err)
+	}
+
+	return league
+}
+
+// LoadLeague returns the scores of all the players like GetLeague, or the error from reading them
+func (s *SQLPlayerStore) LoadLeague() (League, error) {
+	return s.queryLeague(`SELECT name, wins FROM players ORDER BY wins DESC, name ASC`)
+}
+
+// PlayersInRange returns the players with between min and max wins inclusive, most wins first
+func (s *SQLPlayerStore) PlayersInRange(min, max int) []Player {
+	league, err := s.queryLeague(`SELECT name, wins FROM players
+		WHERE wins BETWEEN ? AND ? ORDER BY wins DESC, name ASC`, min, max)
+
+	if err != nil {
+		log.Print(err)
+	}
+
+	if league == nil {
+		return League{}
+	}
+	return league
+}
+
+func (s *SQLPlayerStore) queryLeague(query string, args ...interface{}) (League, error) {
+	rows, err := s.db.Query(query, args...)
+
+	if err != nil {
+		return nil, fmt.Errorf("problem querying league, %v", err)
+	}
+	defer rows.Close()
+
+	var league League
+	for rows.Next() {
+		var player Player
+		if err := rows.Scan(&player.Name, &player.Wins); err != nil {
+			return nil, fmt.Errorf("problem reading player from league, %v", err)
+		}
+		league = append(league, player)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("problem reading league, %v", err)
+	}
+
+	return league, nil
+}
+
+// GetPlayerScore retrieves a player's score
+func (s *SQLPlayerStore) GetPlayerScore(name string) int {
+	var wins int
+	err := s.db.QueryRow(`SELECT wins FROM players WHERE name = ?`, name).Scan(&wins)
+
+	if err != nil && err != sql.ErrNoRows {
+		log.Printf("problem getting score for %s, %v", name, err)
+	}
+
+	return wins
+}
+
+// TotalWins adds up the wins of every player
+func (s *SQLPlayerStore) TotalWins() int {
+	var total int
+	err := s.db.QueryRow(`SELECT COALESCE(SUM(wins), 0) FROM players`).Scan(&total)
+
+	if err != nil {
+		log.Printf("problem getting total wins, %v", err)
+	}
+
+	return total
+}
+
+// RecordWin will store a win for a player, incrementing wins if already known
+func (s *SQLPlayerStore) RecordWin(name string) {
+	err := s.inTransaction(func(tx *sql.Tx) error {
+		_, err := tx.Exec(`INSERT INTO players (name, wins) VALUES (?, 1)
+			ON CONFLICT(name) DO UPDATE SET wins = wins + 1`, name)
+		return err
+	})
+
+	if err != nil {
+		log.Printf("problem recording win for %s, %v", name, err)
+	}
+}
+
+// AddPoints changes a player's score by points, which can be negative, without going below zero
+func (s *SQLPlayerStore) AddPoints(name string, points int) {
+	err := s.inTransaction(func(tx *sql.Tx) error {
+		_, err := tx.Exec(`INSERT INTO players (name, wins) VALUES (?, CASE WHEN ? < 0 THEN 0 ELSE ? END)
+			ON CONFLICT(name) DO UPDATE SET wins = CASE WHEN wins + ? < 0 THEN 0 ELSE wins + ? END`,
+			name, points, points, points, points)
+		return err
+	})
+
+	if err != nil {
+		log.Printf("problem adding %d points for %s, %v", points, name, err)
+	}
+}
+
+// RemovePlayer deletes a player from the store
+func (s *SQLPlayerStore) RemovePlayer(name string) {
+	err := s.inTransaction(func(tx *sql.Tx) error {
+		_, err := tx.Exec(`DELETE FROM players WHERE name = ?`, name)
+		return err
+	})
+
+	if err != nil {
+		log.Printf("problem removing %s, %v", name, err)
+	}
+}
+
+// Rename changes a player's name, keeping their score
+func (s *SQLPlayerStore) Rename(from, to string) error {
+	return s.inTransaction(func(tx *sql.Tx) error {
+		var existing int
+		if err := tx.QueryRow(`SELECT COUNT(*) FROM players WHERE name = ?`, to).Scan(&existing); err != nil {
+			return fmt.Errorf("problem checking for %s, %v", to, err)
+		}
+
+		if existing > 0 {
+			return ErrPlayerExists
+		}
+
+		result, err := tx.Exec(`UPDATE players SET name = ? WHERE name = ?`, to, from)
+		if err != nil {
+			return fmt.Errorf("problem renaming %s to %s, %v", from, to, err)
+		}
+
+		if renamed, err := result.RowsAffected(); err == nil && renamed == 0 {
+			return ErrPlayerNotFound
+		}
+
+		return nil
+	})
+}
+
+// Ping checks the database can still be reached
+func (s *SQLPlayerStore) Ping() error {
+	return s.db.Ping()
+}
+
+func (s *SQLPlayerStore) inTransaction(f func(tx *sql.Tx) error) error {
+	tx, err := s.db.Begin()
+
+	if err != nil {
+		return fmt.Errorf("problem starting transaction, %v", err)
+	}
+
+	if err := f(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
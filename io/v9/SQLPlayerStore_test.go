@@ -0,0 +1,208 @@
+//go:build sqlite
+// +build sqlite
+
+package main
+
+import (
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// run these with go test -tags sqlite, they need cgo to build the sqlite driver
+
+func createInMemoryDB(t *testing.T) (*sql.DB, func()) {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", ":memory:")
+
+	if err != nil {
+		t.Fatalf("could not open in memory database %v", err)
+	}
+
+	// every connection to :memory: gets its own database, so only ever use one
+	db.SetMaxOpenConns(1)
+
+	return db, func() { db.Close() }
+}
+
+func TestSQLPlayerStore(t *testing.T) {
+
+	t.Run("league sorted", func(t *testing.T) {
+		db, closeDB := createInMemoryDB(t)
+		defer closeDB()
+
+		store, err := NewSQLPlayerStore(db)
+		assertNoError(t, err)
+
+		store.RecordWin("Cleo")
+		store.RecordWin("Chris")
+		store.RecordWin("Chris")
+
+		got := store.GetLeague()
+		want := []Player{
+			{"Chris", 2},
+			{"Cleo", 1},
+		}
+
+		assertLeague(t, got, want)
+	})
+
+	t.Run("players with the same wins are sorted by name", func(t *testing.T) {
+		db, closeDB := createInMemoryDB(t)
+		defer closeDB()
+
+		store, err := NewSQLPlayerStore(db)
+		assertNoError(t, err)
+
+		store.RecordWin("Pepper")
+		store.RecordWin("Cleo")
+		store.RecordWin("Chris")
+		store.RecordWin("Chris")
+
+		assertStableLeague(t, store, []Player{
+			{"Chris", 2},
+			{"Cleo", 1},
+			{"Pepper", 1},
+		})
+	})
+
+	t.Run("get player score", func(t *testing.T) {
+		db, closeDB := createInMemoryDB(t)
+		defer closeDB()
+
+		store, err := NewSQLPlayerStore(db)
+		assertNoError(t, err)
+
+		store.RecordWin("Chris")
+		store.RecordWin("Chris")
+
+		assertScoreEquals(t, store.GetPlayerScore("Chris"), 2)
+		assertScoreEquals(t, store.GetPlayerScore("Pepper"), 0)
+	})
+
+	t.Run("store wins for new players", func(t *testing.T) {
+		db, closeDB := createInMemoryDB(t)
+		defer closeDB()
+
+		store, err := NewSQLPlayerStore(db)
+		assertNoError(t, err)
+
+		store.RecordWin("Pepper")
+
+		assertScoreEquals(t, store.GetPlayerScore("Pepper"), 1)
+	})
+
+	t.Run("add points", func(t *testing.T) {
+		db, closeDB := createInMemoryDB(t)
+		defer closeDB()
+
+		store, err := NewSQLPlayerStore(db)
+		assertNoError(t, err)
+
+		store.RecordWin("Chris")
+		store.AddPoints("Chris", 5)
+		store.AddPoints("Cleo", 3)
+		store.AddPoints("Cleo", -5)
+		store.AddPoints("Pepper", -1)
+
+		assertScoreEquals(t, store.GetPlayerScore("Chris"), 6)
+		assertScoreEquals(t, store.GetPlayerScore("Cleo"), 0)
+		assertLeague(t, store.GetLeague(), []Player{{"Chris", 6}, {"Cleo", 0}, {"Pepper", 0}})
+	})
+
+	t.Run("players in range", func(t *testing.T) {
+		db, closeDB := createInMemoryDB(t)
+		defer closeDB()
+
+		store, err := NewSQLPlayerStore(db)
+		assertNoError(t, err)
+
+		store.AddPoints("Cleo", 10)
+		store.AddPoints("Chris", 33)
+		store.AddPoints("Tiest", 4)
+
+		assertLeague(t, store.PlayersInRange(4, 10), []Player{{"Cleo", 10}, {"Tiest", 4}})
+		assertLeague(t, store.PlayersInRange(10, 4), []Player{})
+	})
+
+	t.Run("total wins", func(t *testing.T) {
+		db, closeDB := createInMemoryDB(t)
+		defer closeDB()
+
+		store, err := NewSQLPlayerStore(db)
+		assertNoError(t, err)
+		assertScoreEquals(t, store.TotalWins(), 0)
+
+		store.AddPoints("Cleo", 10)
+		store.RecordWin("Chris")
+
+		assertScoreEquals(t, store.TotalWins(), 11)
+	})
+
+	t.Run("remove and rename players", func(t *testing.T) {
+		db, closeDB := createInMemoryDB(t)
+		defer closeDB()
+
+		store, err := NewSQLPlayerStore(db)
+		assertNoError(t, err)
+
+		store.RecordWin("Cleo")
+		store.RecordWin("Chris")
+		store.RecordWin("Tiest")
+
+		store.RemovePlayer("Cleo")
+		assertNoError(t, store.Rename("Chris", "Pepper"))
+
+		if err := store.Rename("Cleo", "Floyd"); err != ErrPlayerNotFound {
+			t.Errorf("got error %v want %v", err, ErrPlayerNotFound)
+		}
+
+		if err := store.Rename("Pepper", "Tiest"); err != ErrPlayerExists {
+			t.Errorf("got error %v want %v", err, ErrPlayerExists)
+		}
+
+		assertLeague(t, store.GetLeague(), []Player{{"Pepper", 1}, {"Tiest", 1}})
+	})
+
+	t.Run("works with an existing table", func(t *testing.T) {
+		db, closeDB := createInMemoryDB(t)
+		defer closeDB()
+
+		first, err := NewSQLPlayerStore(db)
+		assertNoError(t, err)
+		first.RecordWin("Cleo")
+
+		second, err := NewSQLPlayerStore(db)
+		assertNoError(t, err)
+
+		assertScoreEquals(t, second.GetPlayerScore("Cleo"), 1)
+	})
+
+	t.Run("seeding adds players, including those with no wins", func(t *testing.T) {
+		db, closeDB := createInMemoryDB(t)
+		defer closeDB()
+
+		store, err := NewSQLPlayerStore(db)
+		assertNoError(t, err)
+
+		assertNoError(t, SeedPlayers(store, []Player{{"Cleo", 3}, {"Tiest", 0}}))
+		assertLeague(t, store.GetLeague(), []Player{{"Cleo", 3}, {"Tiest", 0}})
+	})
+
+	t.Run("seeding a store which already has players is rejected", func(t *testing.T) {
+		db, closeDB := createInMemoryDB(t)
+		defer closeDB()
+
+		store, err := NewSQLPlayerStore(db)
+		assertNoError(t, err)
+
+		store.RecordWin("Cleo")
+
+		if err := SeedPlayers(store, []Player{{"Cleo", 3}}); err != ErrStoreNotEmpty {
+			t.Errorf("got error %v want %v", err, ErrStoreNotEmpty)
+		}
+		assertScoreEquals(t, store.GetPlayerScore("Cleo"), 1)
+	})
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/quii/learn-go-with-tests/internal/clock"
+)
+
+// ErrMissingStore means a Config was used without a PlayerStore
+var ErrMissingStore = errors.New("config must have a player store")
+
+// Config holds everything needed to build a PlayerServer in one place
+type Config struct {
+	// Store is required, the rest are optional
+	Store PlayerStore
+
+	// RequestTimeout is how long a request can take before it gets a 503, zero means no limit
+	RequestTimeout time.Duration
+
+	// AllowedOrigins are the origins browsers may make cross origin requests from, "*" allows any
+	AllowedOrigins []string
+
+	// Logger gets a line for each request, nil means requests are not logged
+	Logger *log.Logger
+
+	// MaxBodyBytes limits the size of request bodies, zero means DefaultMaxBodyBytes
+	MaxBodyBytes int64
+
+	// Clock tells the server the time, nil means the system clock
+	Clock clock.Clock
+}
+
+// Middleware wraps a http.Handler to add behaviour around it
+type Middleware func(http.Handler) http.Handler
+
+// NewPlayerServerFromConfig creates a PlayerServer from cfg, wrapping its
+// routes in middleware for logging, CORS and timeouts as configured
+func NewPlayerServerFromConfig(cfg Config) (*PlayerServer, error) {
+	if cfg.Store == nil {
+		return nil, ErrMissingStore
+	}
+
+	var options []PlayerServerOption
+	if cfg.MaxBodyBytes > 0 {
+		options = append(options, WithMaxBodyBytes(cfg.MaxBodyBytes))
+	}
+	if cfg.Clock != nil {
+		options = append(options, WithClock(cfg.Clock))
+	}
+
+	p := NewPlayerServer(cfg.Store, options...)
+
+	var middleware []Middleware
+	if cfg.Logger != nil {
+		middleware = append(middleware, logRequests(cfg.Logger))
+	}
+	if len(cfg.AllowedOrigins) > 0 {
+		middleware = append(middleware, allowOrigins(cfg.AllowedOrigins))
+	}
+	if cfg.RequestTimeout > 0 {
+		middleware = append(middleware, timeout(cfg.RequestTimeout))
+	}
+
+	p.Handler = chain(p.Handler, middleware...)
+
+	return p, nil
+}
+
+// chain wraps handler in middleware, the first of which sees each request first
+func chain(handler http.Handler, middleware ...Middleware) http.Handler {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		handler = middleware[i](handler)
+	}
+	return handler
+}
+
+func timeout(d time.Duration) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.TimeoutHandler(next, d, "request took too long")
+	}
+}
+
+// allowOrigins adds CORS headers to requests from the origins, answering preflight requests itself
+func allowOrigins(origins []string) Middleware {
+	allowed := make(map[string]bool)
+	for _, origin := range origins {
+		allowed[origin] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("origin")
+
+			if origin != "" && (allowed[origin] || allowed["*"]) {
+				w.Header().Set("access-control-allow-origin", origin)
+				w.Header().Add("vary", "origin")
+
+				if r.Method == http.MethodOptions {
+					w.Header().Set("access-control-allow-methods", "GET, POST, OPTIONS")
+					w.Header().Set("access-control-allow-headers", "content-type")
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// statusRecorder remembers the status code written so it can be logged
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func logRequests(logger *log.Logger) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			recorder := &statusRecorder{w, http.StatusOK}
+			next.ServeHTTP(recorder, r)
+			logger.Printf("%s %s %d", r.Method, r.URL.Path, recorder.status)
+		})
+	}
+}
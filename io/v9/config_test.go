@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type slowPlayerStore struct {
+	StubPlayerStore
+	delay time.Duration
+}
+
+func (s *slowPlayerStore) GetLeague() League {
+	time.Sleep(s.delay)
+	return s.StubPlayerStore.GetLeague()
+}
+
+func TestNewPlayerServerFromConfig(t *testing.T) {
+	t.Run("a valid config builds a working server", func(t *testing.T) {
+		var logs bytes.Buffer
+		store := StubPlayerStore{map[string]int{"Pepper": 20}, nil, nil}
+
+		server, err := NewPlayerServerFromConfig(Config{
+			Store:          &store,
+			RequestTimeout: time.Second,
+			AllowedOrigins: []string{"http://example.com"},
+			Logger:         log.New(&logs, "", 0),
+		})
+		assertNoError(t, err)
+
+		request := newGetScoreRequest("Pepper")
+		request.Header.Set("origin", "http://example.com")
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, request)
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertResponseBody(t, response.Body.String(), "20")
+		assertHeader(t, response, "access-control-allow-origin", "http://example.com")
+		assertResponseBody(t, logs.String(), "GET /players/Pepper 200\n")
+	})
+
+	t.Run("a missing store is an error", func(t *testing.T) {
+		_, err := NewPlayerServerFromConfig(Config{RequestTimeout: time.Second})
+
+		if err != ErrMissingStore {
+			t.Errorf("got error %v want %v", err, ErrMissingStore)
+		}
+	})
+
+	t.Run("only allowed origins get CORS headers", func(t *testing.T) {
+		server, _ := NewPlayerServerFromConfig(Config{
+			Store:          &StubPlayerStore{},
+			AllowedOrigins: []string{"http://example.com"},
+		})
+
+		request := newLeagueRequest()
+		request.Header.Set("origin", "http://evil.com")
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, request)
+
+		assertHeader(t, response, "access-control-allow-origin", "")
+	})
+
+	t.Run("preflight requests are answered", func(t *testing.T) {
+		server, _ := NewPlayerServerFromConfig(Config{
+			Store:          &StubPlayerStore{},
+			AllowedOrigins: []string{"*"},
+		})
+
+		request, _ := http.NewRequest(http.MethodOptions, "/league", nil)
+		request.Header.Set("origin", "http://example.com")
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, request)
+
+		assertStatus(t, response.Code, http.StatusNoContent)
+		assertHeader(t, response, "access-control-allow-origin", "http://example.com")
+	})
+
+	t.Run("slow requests time out", func(t *testing.T) {
+		server, _ := NewPlayerServerFromConfig(Config{
+			Store:          &slowPlayerStore{delay: 50 * time.Millisecond},
+			RequestTimeout: 5 * time.Millisecond,
+		})
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newLeagueRequest())
+
+		assertStatus(t, response.Code, http.StatusServiceUnavailable)
+	})
+}
+
+func assertHeader(t *testing.T, response *httptest.ResponseRecorder, header, want string) {
+	t.Helper()
+	if got := response.Header().Get(header); got != want {
+		t.Errorf("got header %s %q want %q", header, got, want)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"sort"
 )
 
 // League stores a collection of players
@@ -19,14 +20,108 @@ func (l League) Find(name string) *Player {
 	return nil
 }
 
+// InRange returns the players with between min and max wins inclusive, most
+// wins first and then by name. An inverted range, where min is more than max, is empty
+func (l League) InRange(min, max int) League {
+	inRange := League{}
+
+	for _, player := range l {
+		if player.Wins >= min && player.Wins <= max {
+			inRange = append(inRange, player)
+		}
+	}
+
+	inRange.Sort()
+
+	return inRange
+}
+
+// Sort orders the league in place, most wins first and then by name so players
+// with the same wins always come back in the same order
+func (l League) Sort() {
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].Wins != l[j].Wins {
+			return l[i].Wins > l[j].Wins
+		}
+		return l[i].Name < l[j].Name
+	})
+}
+
+// LeagueStats summarises the spread of wins across a league
+type LeagueStats struct {
+	Count  int     `json:"count"`
+	Mean   float64 `json:"mean"`
+	Median float64 `json:"median"`
+	Max    int     `json:"max"`
+	Min    int     `json:"min"`
+}
+
+// Stats works out the LeagueStats of the league, an empty league has all zero stats
+func (l League) Stats() LeagueStats {
+	if len(l) == 0 {
+		return LeagueStats{}
+	}
+
+	wins := make([]int, len(l))
+	total := 0
+	for i, player := range l {
+		wins[i] = player.Wins
+		total += player.Wins
+	}
+	sort.Ints(wins)
+
+	middle := len(wins) / 2
+	median := float64(wins[middle])
+	if len(wins)%2 == 0 {
+		median = float64(wins[middle-1]+wins[middle]) / 2
+	}
+
+	return LeagueStats{
+		Count:  len(wins),
+		Mean:   float64(total) / float64(len(wins)),
+		Median: median,
+		Max:    wins[len(wins)-1],
+		Min:    wins[0],
+	}
+}
+
 // NewLeague creates a league from JSON
 func NewLeague(rdr io.Reader) (League, error) {
 	var league []Player
 	err := json.NewDecoder(rdr).Decode(&league)
 
 	if err != nil {
-		err = fmt.Errorf("problem parsing league, %v", err)
+		return league, fmt.Errorf("problem parsing league, %v", err)
+	}
+
+	for _, player := range league {
+		if err := player.Validate(); err != nil {
+			return league, fmt.Errorf("problem with player %q in league, %v", player.Name, err)
+		}
+	}
+
+	return league, nil
+}
+
+// CompareLeagues returns how many wins each player gained between the before
+// and after leagues. New players gain all of their wins, players missing from
+// after are treated as having no wins, and players whose wins did not change are left out
+func CompareLeagues(before, after []Player) map[string]int {
+	deltas := make(map[string]int)
+
+	for _, player := range after {
+		deltas[player.Name] += player.Wins
+	}
+
+	for _, player := range before {
+		deltas[player.Name] -= player.Wins
+	}
+
+	for name, delta := range deltas {
+		if delta == 0 {
+			delete(deltas, name)
+		}
 	}
 
-	return league, err
+	return deltas
 }
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompareLeagues(t *testing.T) {
+	before := []Player{
+		{"Cleo", 10},
+		{"Chris", 33},
+		{"Tiest", 4},
+	}
+
+	after := []Player{
+		{"Chris", 35},
+		{"Cleo", 13},
+		{"Tiest", 4},
+		{"Pepper", 2},
+	}
+
+	got := CompareLeagues(before, after)
+	want := map[string]int{
+		"Chris":  2,
+		"Cleo":   3,
+		"Pepper": 2,
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+}
+
+func TestLeagueStats(t *testing.T) {
+	league := League{
+		{"Cleo", 10},
+		{"Chris", 33},
+		{"Tiest", 4},
+	}
+
+	got := league.Stats()
+	want := LeagueStats{Count: 3, Mean: 47.0 / 3.0, Median: 10, Max: 33, Min: 4}
+
+	if got != want {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
+
+func TestLeagueInRange(t *testing.T) {
+	league := League{
+		{"Cleo", 10},
+		{"Chris", 33},
+		{"Tiest", 4},
+		{"Pepper", 10},
+		{"Floyd", 20},
+	}
+
+	rangeTests := []struct {
+		name     string
+		min, max int
+		want     League
+	}{
+		{name: "normal range", min: 5, max: 25, want: League{{"Floyd", 20}, {"Cleo", 10}, {"Pepper", 10}}},
+		{name: "bounds are inclusive", min: 4, max: 10, want: League{{"Cleo", 10}, {"Pepper", 10}, {"Tiest", 4}}},
+		{name: "inverted range", min: 25, max: 5, want: League{}},
+	}
+
+	for _, tt := range rangeTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := league.InRange(tt.min, tt.max)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLeagueSort(t *testing.T) {
+	league := League{
+		{"Pepper", 10},
+		{"Tiest", 4},
+		{"Chris", 33},
+		{"Cleo", 10},
+	}
+
+	league.Sort()
+
+	want := League{{"Chris", 33}, {"Cleo", 10}, {"Pepper", 10}, {"Tiest", 4}}
+	if !reflect.DeepEqual(league, want) {
+		t.Errorf("got %v want %v", league, want)
+	}
+}
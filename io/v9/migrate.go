@@ -0,0 +1,26 @@
+package main
+
+import "fmt"
+
+// Migrate copies every player in src into dst, keeping their wins, using
+// SeedPlayers. Every player is validated before dst is changed. If src is a
+// LeagueLoader and its league can't be read then nothing is migrated
+func Migrate(src, dst PlayerStore) error {
+	league, err := loadLeague(src)
+	if err != nil {
+		return fmt.Errorf("problem reading players to migrate, %v", err)
+	}
+
+	if err := SeedPlayers(dst, league); err != nil {
+		return fmt.Errorf("problem migrating players, %v", err)
+	}
+
+	return nil
+}
+
+func loadLeague(store PlayerStore) (League, error) {
+	if loader, ok := store.(LeagueLoader); ok {
+		return loader.LoadLeague()
+	}
+	return store.GetLeague(), nil
+}
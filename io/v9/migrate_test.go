@@ -0,0 +1,89 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMigrate(t *testing.T) {
+	newSeededStore := func() *InMemoryPlayerStore {
+		store := NewInMemoryPlayerStore()
+		store.AddPoints("Cleo", 32)
+		store.AddPoints("Chris", 20)
+		store.RecordWin("Tiest")
+		return store
+	}
+
+	t.Run("from an in memory store into a file store", func(t *testing.T) {
+		database, cleanDatabase := createTempFile(t, "")
+		defer cleanDatabase()
+
+		dst, err := NewFileSystemPlayerStore(database)
+		assertNoError(t, err)
+
+		err = Migrate(newSeededStore(), dst)
+		assertNoError(t, err)
+
+		want := []Player{{"Cleo", 32}, {"Chris", 20}, {"Tiest", 1}}
+		assertLeague(t, dst.GetLeague(), want)
+
+		// reload from the file to check the migration was saved
+		reloaded, err := NewFileSystemPlayerStore(database)
+		assertNoError(t, err)
+		assertLeague(t, reloaded.GetLeague(), want)
+	})
+
+	t.Run("records wins one at a time without a bulk set", func(t *testing.T) {
+		src := NewInMemoryPlayerStore()
+		src.AddPoints("Cleo", 2)
+		dst := &StubPlayerStore{}
+
+		err := Migrate(src, dst)
+		assertNoError(t, err)
+
+		if want := []string{"Cleo", "Cleo"}; !reflect.DeepEqual(dst.winCalls, want) {
+			t.Errorf("got win calls %q want %q", dst.winCalls, want)
+		}
+	})
+
+	t.Run("rejects invalid players without changing dst", func(t *testing.T) {
+		src := &StubPlayerStore{league: []Player{{"Cleo", 2}, {"", 3}}}
+		dst := NewInMemoryPlayerStore()
+
+		if err := Migrate(src, dst); err == nil {
+			t.Fatal("expected an error migrating a player without a name")
+		}
+
+		if len(dst.GetLeague()) != 0 {
+			t.Errorf("expected nothing to be migrated but got %v", dst.GetLeague())
+		}
+	})
+
+	t.Run("rejects a dst without bulk operations which already has players", func(t *testing.T) {
+		dst := &StubPlayerStore{league: []Player{{"Chris", 1}}}
+
+		if err := Migrate(newSeededStore(), dst); err == nil {
+			t.Fatal("expected an error migrating into a store which already has players")
+		}
+
+		if len(dst.winCalls) != 0 {
+			t.Errorf("expected no wins to be recorded but got %q", dst.winCalls)
+		}
+	})
+
+	t.Run("fails when the league can't be read from src", func(t *testing.T) {
+		src, server := createRedisPlayerStore(t)
+		src.RecordWin("Cleo")
+		server.Close()
+
+		dst := NewInMemoryPlayerStore()
+
+		if err := Migrate(src, dst); err == nil {
+			t.Fatal("expected an error migrating from an unreachable store")
+		}
+
+		if len(dst.GetLeague()) != 0 {
+			t.Errorf("expected nothing to be migrated but got %v", dst.GetLeague())
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package main
+
+import "errors"
+
+var (
+	// ErrEmptyPlayerName means a player was given without a name
+	ErrEmptyPlayerName = errors.New("player name cannot be empty")
+
+	// ErrNegativeWins means a player was given fewer than zero wins
+	ErrNegativeWins = errors.New("player wins cannot be negative")
+
+	// ErrPlayerNotFound means the player is not in the store
+	ErrPlayerNotFound = errors.New("player not found")
+
+	// ErrPlayerExists means a player with that name is already in the store
+	ErrPlayerExists = errors.New("player already exists")
+)
+
+// Player stores a name with a number of wins
+type Player struct {
+	Name string `json:"name"`
+	Wins int    `json:"wins"`
+}
+
+// Validate checks the player has a name and a sensible number of wins
+func (p Player) Validate() error {
+	if p.Name == "" {
+		return ErrEmptyPlayerName
+	}
+
+	if p.Wins < 0 {
+		return ErrNegativeWins
+	}
+
+	return nil
+}
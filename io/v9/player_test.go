@@ -0,0 +1,49 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPlayerJSON(t *testing.T) {
+	t.Run("marshals using lower case keys", func(t *testing.T) {
+		got, err := json.Marshal(Player{"Cleo", 32})
+
+		assertNoError(t, err)
+		assertResponseBody(t, string(got), `{"name":"Cleo","wins":32}`)
+	})
+
+	t.Run("unmarshals from lower case keys", func(t *testing.T) {
+		var got Player
+		err := json.Unmarshal([]byte(`{"name":"Chris","wins":20}`), &got)
+
+		assertNoError(t, err)
+
+		if want := (Player{"Chris", 20}); got != want {
+			t.Errorf("got %v want %v", got, want)
+		}
+	})
+}
+
+func TestPlayerValidate(t *testing.T) {
+	cases := []struct {
+		name   string
+		player Player
+		want   error
+	}{
+		{"valid player", Player{"Cleo", 32}, nil},
+		{"valid player with no wins", Player{"Cleo", 0}, nil},
+		{"empty name", Player{"", 32}, ErrEmptyPlayerName},
+		{"negative wins", Player{"Cleo", -1}, ErrNegativeWins},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := c.player.Validate()
+
+			if got != c.want {
+				t.Errorf("got error %v want %v", got, c.want)
+			}
+		})
+	}
+}
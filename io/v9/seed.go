@@ -0,0 +1,60 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrStoreNotEmpty means players were seeded into a store which already has
+	// players and cannot replace their scores
+	ErrStoreNotEmpty = errors.New("cannot seed a store which already has players")
+
+	// ErrCannotSeedNoWins means a player with no wins was seeded into a store
+	// which can only add players by recording a win
+	ErrCannotSeedNoWins = errors.New("cannot seed a player with no wins into a store which only records wins")
+)
+
+// SeedPlayers sets each of the seeds' wins in store, replacing the scores of
+// any players already there. Stores which implement BulkScoreStore are given
+// all the seeds at once. Other stores can't replace scores, so they must be
+// empty and their players are added one at a time, with AddPoints if the store
+// is a PointsStore or otherwise by recording a win for each of their wins.
+// Every seed is checked before store is changed, so an error leaves store untouched
+func SeedPlayers(store PlayerStore, seeds []Player) error {
+	for _, player := range seeds {
+		if err := player.Validate(); err != nil {
+			return fmt.Errorf("problem with seed player %q, %v", player.Name, err)
+		}
+	}
+
+	if bulk, ok := store.(BulkScoreStore); ok {
+		bulk.SetScores(seeds)
+		return nil
+	}
+
+	if len(store.GetLeague()) > 0 {
+		return ErrStoreNotEmpty
+	}
+
+	if points, ok := store.(PointsStore); ok {
+		for _, player := range seeds {
+			points.AddPoints(player.Name, player.Wins)
+		}
+		return nil
+	}
+
+	for _, player := range seeds {
+		if player.Wins == 0 {
+			return fmt.Errorf("problem with seed player %q, %v", player.Name, ErrCannotSeedNoWins)
+		}
+	}
+
+	for _, player := range seeds {
+		for i := 0; i < player.Wins; i++ {
+			store.RecordWin(player.Name)
+		}
+	}
+
+	return nil
+}
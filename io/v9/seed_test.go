@@ -0,0 +1,76 @@
+package main
+
+import "testing"
+
+func TestSeedPlayers(t *testing.T) {
+	seeds := []Player{{"Cleo", 32}, {"Chris", 20}, {"Tiest", 0}}
+
+	t.Run("seeds a fresh file store", func(t *testing.T) {
+		database, cleanDatabase := createTempFile(t, "")
+		defer cleanDatabase()
+
+		store, err := NewFileSystemPlayerStore(database)
+		assertNoError(t, err)
+
+		assertNoError(t, SeedPlayers(store, seeds))
+		assertLeague(t, store.GetLeague(), seeds)
+	})
+
+	t.Run("seeds a store without bulk operations", func(t *testing.T) {
+		store := &StubPlayerStore{}
+
+		assertNoError(t, SeedPlayers(store, []Player{{"Cleo", 1}, {"Chris", 2}}))
+
+		if len(store.winCalls) != 3 {
+			t.Errorf("got %d wins recorded want 3", len(store.winCalls))
+		}
+	})
+
+	t.Run("replaces the scores of players already in a bulk store", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+		store.AddPoints("Cleo", 5)
+
+		assertNoError(t, SeedPlayers(store, seeds))
+		assertLeague(t, store.GetLeague(), []Player{{"Cleo", 32}, {"Chris", 20}, {"Tiest", 0}})
+	})
+
+	t.Run("rejects a store without bulk operations which already has players", func(t *testing.T) {
+		store := &StubPlayerStore{league: []Player{{"Cleo", 5}}}
+
+		err := SeedPlayers(store, []Player{{"Cleo", 1}})
+
+		if err != ErrStoreNotEmpty {
+			t.Errorf("got error %v want %v", err, ErrStoreNotEmpty)
+		}
+
+		if len(store.winCalls) != 0 {
+			t.Errorf("expected no wins to be recorded but got %q", store.winCalls)
+		}
+	})
+
+	t.Run("rejects players with no wins for a store which only records wins", func(t *testing.T) {
+		store := &StubPlayerStore{}
+
+		if err := SeedPlayers(store, seeds); err == nil {
+			t.Fatal("expected an error seeding a player with no wins")
+		}
+
+		if len(store.winCalls) != 0 {
+			t.Errorf("expected no wins to be recorded but got %q", store.winCalls)
+		}
+	})
+
+	t.Run("negative wins are rejected before anything is seeded", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+
+		err := SeedPlayers(store, []Player{{"Cleo", 32}, {"Chris", -1}})
+
+		if err == nil {
+			t.Fatal("expected an error seeding a player with negative wins")
+		}
+
+		if len(store.GetLeague()) != 0 {
+			t.Errorf("expected nothing to be seeded but got %v", store.GetLeague())
+		}
+	})
+}
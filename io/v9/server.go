@@ -2,8 +2,18 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/quii/learn-go-with-tests/internal/clock"
 )
 
 // PlayerStore stores score information about players
@@ -11,31 +21,104 @@ type PlayerStore interface {
 	GetPlayerScore(name string) int
 	RecordWin(name string)
 	GetLeague() League
+	Ping() error
+}
+
+// LossStore is implemented by stores which record losses as well as wins
+type LossStore interface {
+	RecordLoss(name string)
+}
+
+// PointsStore is implemented by stores which can award a player any number of points
+type PointsStore interface {
+	AddPoints(name string, points int)
+}
+
+// ManagedPlayerStore is a PlayerStore which also lets players be changed and removed
+type ManagedPlayerStore interface {
+	PlayerStore
+	PointsStore
+	RemovePlayer(name string)
+	Rename(from, to string) error
+}
+
+// RangeStore is implemented by stores which can find the players with a number of wins in a range
+type RangeStore interface {
+	PlayersInRange(min, max int) []Player
 }
 
-// Player stores a name with a number of wins
-type Player struct {
-	Name string
-	Wins int
+// TotalWinsStore is implemented by stores which can add up every player's wins
+type TotalWinsStore interface {
+	TotalWins() int
+}
+
+// LeagueLoader is implemented by stores which can fail to read the league, so
+// they can report why rather than GetLeague returning nothing
+type LeagueLoader interface {
+	LoadLeague() (League, error)
+}
+
+// BulkScoreStore is implemented by stores which can set many players' scores in one go
+type BulkScoreStore interface {
+	SetScores(players []Player)
+}
+
+// LastWinStore is implemented by stores which know when each player last won
+type LastWinStore interface {
+	LastWin(name string) (time.Time, bool)
 }
 
 // PlayerServer is a HTTP interface for player information
 type PlayerServer struct {
 	store PlayerStore
 	http.Handler
+	maxBodyBytes int64
+	clock        clock.Clock
+}
+
+// PlayerServerOption changes how a PlayerServer behaves
+type PlayerServerOption func(*PlayerServer)
+
+// WithClock sets the clock the server uses to find out the current time
+func WithClock(clock clock.Clock) PlayerServerOption {
+	return func(p *PlayerServer) {
+		p.clock = clock
+	}
+}
+
+// WithMaxBodyBytes limits the size of request bodies sent to POST endpoints
+func WithMaxBodyBytes(n int64) PlayerServerOption {
+	return func(p *PlayerServer) {
+		p.maxBodyBytes = n
+	}
 }
 
 const jsonContentType = "application/json"
+const textContentType = "text/plain"
+
+// DefaultMaxBodyBytes is the limit on request bodies unless WithMaxBodyBytes is used
+const DefaultMaxBodyBytes = 1 << 20
 
 // NewPlayerServer creates a PlayerServer with routing configured
-func NewPlayerServer(store PlayerStore) *PlayerServer {
+func NewPlayerServer(store PlayerStore, options ...PlayerServerOption) *PlayerServer {
 	p := new(PlayerServer)
 
 	p.store = store
+	p.maxBodyBytes = DefaultMaxBodyBytes
+	p.clock = clock.RealClock{}
+
+	for _, option := range options {
+		option(p)
+	}
 
 	router := http.NewServeMux()
 	router.Handle("/league", http.HandlerFunc(p.leagueHandler))
+	router.Handle("/league/stats", http.HandlerFunc(p.leagueStatsHandler))
+	router.Handle("/league/total", http.HandlerFunc(p.leagueTotalHandler))
 	router.Handle("/players/", http.HandlerFunc(p.playersHandler))
+	router.Handle("/health", http.HandlerFunc(p.healthHandler))
+	router.Handle("/compare", http.HandlerFunc(p.compareHandler))
+	router.Handle("/inactive", http.HandlerFunc(p.inactiveHandler))
 
 	p.Handler = router
 
@@ -43,32 +126,301 @@ func NewPlayerServer(store PlayerStore) *PlayerServer {
 }
 
 func (p *PlayerServer) leagueHandler(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	if query.Get("minWins") == "" && query.Get("maxWins") == "" {
+		w.Header().Set("content-type", jsonContentType)
+		json.NewEncoder(w).Encode(p.store.GetLeague())
+		return
+	}
+
+	min, minErr := winsParam(query.Get("minWins"), 0)
+	max, maxErr := winsParam(query.Get("maxWins"), math.MaxInt32)
+
+	if minErr != nil || maxErr != nil {
+		http.Error(w, "minWins and maxWins must be whole numbers", http.StatusBadRequest)
+		return
+	}
+
+	store, ok := p.store.(RangeStore)
+
+	if !ok {
+		http.Error(w, "this store cannot find players in a range of wins", http.StatusNotImplemented)
+		return
+	}
+
+	w.Header().Set("content-type", jsonContentType)
+	json.NewEncoder(w).Encode(store.PlayersInRange(min, max))
+}
+
+// winsParam parses a number of wins from a query parameter, using fallback when it is missing
+func winsParam(value string, fallback int) (int, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	return strconv.Atoi(value)
+}
+
+func (p *PlayerServer) leagueStatsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", jsonContentType)
+	json.NewEncoder(w).Encode(p.store.GetLeague().Stats())
+}
+
+// LeagueTotal is the grand total of wins across the league
+type LeagueTotal struct {
+	Total int `json:"total"`
+}
+
+func (p *PlayerServer) leagueTotalHandler(w http.ResponseWriter, r *http.Request) {
+	store, ok := p.store.(TotalWinsStore)
+
+	if !ok {
+		http.Error(w, "this store cannot total wins", http.StatusNotImplemented)
+		return
+	}
+
+	w.Header().Set("content-type", jsonContentType)
+	json.NewEncoder(w).Encode(LeagueTotal{store.TotalWins()})
+}
+
+type healthStatus struct {
+	Status string `json:"status"`
+}
+
+func (p *PlayerServer) healthHandler(w http.ResponseWriter, r *http.Request) {
+	status := healthStatus{"ok"}
+
+	w.Header().Set("content-type", jsonContentType)
+
+	if err := p.store.Ping(); err != nil {
+		status.Status = "degraded"
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	json.NewEncoder(w).Encode(status)
+}
+
+// Comparison puts two players side by side, Leader is the name of whoever has more wins or "tie"
+type Comparison struct {
+	A      Player `json:"a"`
+	B      Player `json:"b"`
+	Leader string `json:"leader"`
+}
+
+func (p *PlayerServer) compareHandler(w http.ResponseWriter, r *http.Request) {
+	a := Player{r.URL.Query().Get("a"), 0}
+	b := Player{r.URL.Query().Get("b"), 0}
+
+	a.Wins = p.store.GetPlayerScore(a.Name)
+	b.Wins = p.store.GetPlayerScore(b.Name)
+
+	for _, player := range []Player{a, b} {
+		if player.Wins == 0 {
+			http.Error(w, fmt.Sprintf("player %q not found", player.Name), http.StatusNotFound)
+			return
+		}
+	}
+
+	comparison := Comparison{A: a, B: b, Leader: "tie"}
+	if a.Wins > b.Wins {
+		comparison.Leader = a.Name
+	}
+	if b.Wins > a.Wins {
+		comparison.Leader = b.Name
+	}
+
+	w.Header().Set("content-type", jsonContentType)
+	json.NewEncoder(w).Encode(comparison)
+}
+
+func (p *PlayerServer) inactiveHandler(w http.ResponseWriter, r *http.Request) {
+	days, err := strconv.Atoi(r.URL.Query().Get("days"))
+
+	if err != nil || days < 0 {
+		http.Error(w, "days must be a whole number of days, 0 or more", http.StatusBadRequest)
+		return
+	}
+
+	store, ok := p.store.(LastWinStore)
+
+	if !ok {
+		http.Error(w, "this store does not know when players last won", http.StatusNotImplemented)
+		return
+	}
+
+	cutoff := p.clock.Now().AddDate(0, 0, -days)
+	inactive := League{}
+
+	for _, player := range p.store.GetLeague() {
+		lastWin, won := store.LastWin(player.Name)
+		if !won || lastWin.Before(cutoff) {
+			inactive = append(inactive, player)
+		}
+	}
+
+	sort.Slice(inactive, func(i, j int) bool {
+		return inactive[i].Name < inactive[j].Name
+	})
+
 	w.Header().Set("content-type", jsonContentType)
-	json.NewEncoder(w).Encode(p.store.GetLeague())
+	json.NewEncoder(w).Encode(inactive)
 }
 
 func (p *PlayerServer) playersHandler(w http.ResponseWriter, r *http.Request) {
 	player := r.URL.Path[len("/players/"):]
 
+	if strings.HasSuffix(player, "/loss") && r.Method == http.MethodPost {
+		p.processLoss(w, strings.TrimSuffix(player, "/loss"))
+		return
+	}
+
 	switch r.Method {
 	case http.MethodPost:
-		p.processWin(w, player)
+		p.processWin(w, r, player)
 	case http.MethodGet:
-		p.showScore(w, player)
+		p.showScore(w, r, player)
 	}
 }
 
-func (p *PlayerServer) showScore(w http.ResponseWriter, player string) {
+func (p *PlayerServer) showScore(w http.ResponseWriter, r *http.Request, player string) {
+	contentType, ok := negotiateContentType(r.Header.Get("accept"))
+
+	if !ok {
+		http.Error(w, fmt.Sprintf("can only respond with %s or %s", textContentType, jsonContentType), http.StatusNotAcceptable)
+		return
+	}
+
 	score := p.store.GetPlayerScore(player)
 
+	w.Header().Set("content-type", contentType)
+
 	if score == 0 {
 		w.WriteHeader(http.StatusNotFound)
 	}
 
+	if contentType == jsonContentType {
+		json.NewEncoder(w).Encode(Player{player, score})
+		return
+	}
+
 	fmt.Fprint(w, score)
 }
 
-func (p *PlayerServer) processWin(w http.ResponseWriter, player string) {
-	p.store.RecordWin(player)
+// negotiateContentType picks the first content type in an Accept header that
+// we can respond with, defaulting to plain text when there is no preference
+func negotiateContentType(accept string) (string, bool) {
+	if accept == "" {
+		return textContentType, true
+	}
+
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType := strings.TrimSpace(strings.Split(mediaRange, ";")[0])
+
+		switch mediaType {
+		case textContentType, "text/*", "*/*":
+			return textContentType, true
+		case jsonContentType:
+			return jsonContentType, true
+		}
+	}
+
+	return "", false
+}
+
+// WinRequest is the optional JSON body of a POST to /players/{name}, awarding
+// Points instead of a single win
+type WinRequest struct {
+	Points int `json:"points"`
+}
+
+func (p *PlayerServer) processWin(w http.ResponseWriter, r *http.Request, player string) {
+	body, ok := p.readBody(w, r)
+	if !ok {
+		return
+	}
+
+	if err := (Player{Name: player}).Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if len(body) == 0 {
+		p.store.RecordWin(player)
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	var win WinRequest
+	if err := json.Unmarshal(body, &win); err != nil {
+		http.Error(w, fmt.Sprintf("problem parsing request body, %v", err), http.StatusBadRequest)
+		return
+	}
+
+	store, ok := p.store.(PointsStore)
+	if !ok {
+		http.Error(w, "this store cannot award points", http.StatusNotImplemented)
+		return
+	}
+
+	store.AddPoints(player, win.Points)
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// processLoss takes a point off the player, their score never goes below zero.
+// Stores which record losses are told about it, otherwise a point is taken away.
+// Players must already be in the league, a loss does not add them
+func (p *PlayerServer) processLoss(w http.ResponseWriter, player string) {
+	if err := (Player{Name: player}).Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// a player with no wins is still a player, so look for them in the league rather than by their score
+	if p.store.GetLeague().Find(player) == nil {
+		http.Error(w, fmt.Sprintf("player %q not found", player), http.StatusNotFound)
+		return
+	}
+
+	switch store := p.store.(type) {
+	case LossStore:
+		store.RecordLoss(player)
+	case PointsStore:
+		store.AddPoints(player, -1)
+	default:
+		http.Error(w, "this store cannot take points away", http.StatusNotImplemented)
+		return
+	}
+
 	w.WriteHeader(http.StatusAccepted)
 }
+
+// errBodyTooLarge means a request body was longer than the server's maxBodyBytes
+var errBodyTooLarge = errors.New("request body too large")
+
+// readBody reads the request body up to maxBodyBytes, returning false after
+// responding with 413 if the body is too large or 400 if it could not be read
+func (p *PlayerServer) readBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	if r.Body == nil {
+		return nil, true
+	}
+
+	// read one byte past the limit, so a body which is too large can be told apart from one right at it
+	limit := p.maxBodyBytes
+	if limit < math.MaxInt64 {
+		limit++
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, limit))
+
+	if err != nil {
+		http.Error(w, fmt.Sprintf("problem reading request body, %v", err), http.StatusBadRequest)
+		return nil, false
+	}
+
+	if int64(len(body)) > p.maxBodyBytes {
+		http.Error(w, errBodyTooLarge.Error(), http.StatusRequestEntityTooLarge)
+		return nil, false
+	}
+
+	return body, true
+}
@@ -1,12 +1,19 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/quii/learn-go-with-tests/internal/clock"
 )
 
 type StubPlayerStore struct {
@@ -28,6 +35,28 @@ func (s *StubPlayerStore) GetLeague() League {
 	return s.league
 }
 
+func (s *StubPlayerStore) Ping() error {
+	return nil
+}
+
+type lastWinStubPlayerStore struct {
+	StubPlayerStore
+	lastWins map[string]time.Time
+}
+
+func (l *lastWinStubPlayerStore) LastWin(name string) (time.Time, bool) {
+	last, ok := l.lastWins[name]
+	return last, ok
+}
+
+type UnreachablePlayerStore struct {
+	StubPlayerStore
+}
+
+func (u *UnreachablePlayerStore) Ping() error {
+	return errors.New("could not reach store")
+}
+
 func TestGETPlayers(t *testing.T) {
 	store := StubPlayerStore{
 		map[string]int{
@@ -69,6 +98,62 @@ func TestGETPlayers(t *testing.T) {
 	})
 }
 
+func TestGETPlayersContentNegotiation(t *testing.T) {
+	store := StubPlayerStore{
+		map[string]int{
+			"Pepper": 20,
+		},
+		nil,
+		nil,
+	}
+	server := NewPlayerServer(&store)
+
+	t.Run("text/plain returns the bare score", func(t *testing.T) {
+		request := newGetScoreRequest("Pepper")
+		request.Header.Set("accept", "text/plain")
+		response := httptest.NewRecorder()
+
+		server.ServeHTTP(response, request)
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertContentType(t, response, textContentType)
+		assertResponseBody(t, response.Body.String(), "20")
+	})
+
+	t.Run("application/json returns the player", func(t *testing.T) {
+		request := newGetScoreRequest("Pepper")
+		request.Header.Set("accept", "application/json")
+		response := httptest.NewRecorder()
+
+		server.ServeHTTP(response, request)
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertContentType(t, response, jsonContentType)
+		assertResponseBody(t, response.Body.String(), `{"name":"Pepper","wins":20}`+"\n")
+	})
+
+	t.Run("the first supported type listed wins", func(t *testing.T) {
+		request := newGetScoreRequest("Pepper")
+		request.Header.Set("accept", "text/html, application/json;q=0.9, */*;q=0.8")
+		response := httptest.NewRecorder()
+
+		server.ServeHTTP(response, request)
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertContentType(t, response, jsonContentType)
+	})
+
+	t.Run("unsupported types return 406", func(t *testing.T) {
+		request := newGetScoreRequest("Pepper")
+		request.Header.Set("accept", "text/html")
+		response := httptest.NewRecorder()
+
+		server.ServeHTTP(response, request)
+
+		assertStatus(t, response.Code, http.StatusNotAcceptable)
+	})
+}
+
 func TestStoreWins(t *testing.T) {
 	store := StubPlayerStore{
 		map[string]int{},
@@ -95,6 +180,155 @@ func TestStoreWins(t *testing.T) {
 			t.Errorf("did not store correct winner got %q want %q", store.winCalls[0], player)
 		}
 	})
+
+	t.Run("it rejects a body larger than the limit", func(t *testing.T) {
+		store := StubPlayerStore{}
+		server := NewPlayerServer(&store, WithMaxBodyBytes(10))
+
+		request := newPostPointsRequest("Pepper", `{"points": 100}`)
+		response := httptest.NewRecorder()
+
+		server.ServeHTTP(response, request)
+
+		assertStatus(t, response.Code, http.StatusRequestEntityTooLarge)
+
+		if len(store.winCalls) != 0 {
+			t.Errorf("got %d calls to RecordWin want 0", len(store.winCalls))
+		}
+	})
+
+	t.Run("it returns 400 when the body cannot be read", func(t *testing.T) {
+		store := StubPlayerStore{}
+		server := NewPlayerServer(&store, WithMaxBodyBytes(10))
+
+		request := newPostPointsRequest("Pepper", "")
+		request.Body = io.NopCloser(iotest.ErrReader(errors.New("connection reset")))
+		response := httptest.NewRecorder()
+
+		server.ServeHTTP(response, request)
+
+		assertStatus(t, response.Code, http.StatusBadRequest)
+	})
+
+	t.Run("it accepts a body within the limit", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+		server := NewPlayerServer(store, WithMaxBodyBytes(14))
+
+		request := newPostPointsRequest("Pepper", `{"points": 10}`)
+		response := httptest.NewRecorder()
+
+		server.ServeHTTP(response, request)
+
+		assertStatus(t, response.Code, http.StatusAccepted)
+	})
+
+	t.Run("it awards points from a JSON body", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+		store.RecordWin("Pepper")
+		server := NewPlayerServer(store)
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newPostPointsRequest("Pepper", `{"points": 5}`))
+
+		assertStatus(t, response.Code, http.StatusAccepted)
+		assertScoreEquals(t, store.GetPlayerScore("Pepper"), 6)
+	})
+
+	t.Run("it rejects a body which is not JSON", func(t *testing.T) {
+		server := NewPlayerServer(NewInMemoryPlayerStore())
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newPostPointsRequest("Pepper", `five points`))
+
+		assertStatus(t, response.Code, http.StatusBadRequest)
+	})
+
+	t.Run("it returns 501 when the store cannot award points", func(t *testing.T) {
+		server := NewPlayerServer(&StubPlayerStore{})
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newPostPointsRequest("Pepper", `{"points": 5}`))
+
+		assertStatus(t, response.Code, http.StatusNotImplemented)
+	})
+
+	t.Run("it rejects a win without a player name", func(t *testing.T) {
+		store := StubPlayerStore{}
+		server := NewPlayerServer(&store)
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newPostWinRequest(""))
+
+		assertStatus(t, response.Code, http.StatusBadRequest)
+
+		if len(store.winCalls) != 0 {
+			t.Errorf("got %d calls to RecordWin want 0", len(store.winCalls))
+		}
+	})
+}
+
+func TestStoreLosses(t *testing.T) {
+	store := NewInMemoryPlayerStore()
+	store.AddPoints("Pepper", 1)
+	server := NewPlayerServer(store)
+
+	t.Run("it takes a point off on POST", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newPostLossRequest("Pepper"))
+
+		assertStatus(t, response.Code, http.StatusAccepted)
+		assertScoreEquals(t, store.GetPlayerScore("Pepper"), 0)
+	})
+
+	t.Run("it does not go below zero", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newPostLossRequest("Pepper"))
+
+		assertStatus(t, response.Code, http.StatusAccepted)
+		assertScoreEquals(t, store.GetPlayerScore("Pepper"), 0)
+	})
+
+	t.Run("it records the loss in the player's history", func(t *testing.T) {
+		store.RecordWin("Pepper")
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newPostLossRequest("Pepper"))
+
+		assertScoreEquals(t, store.CurrentStreak("Pepper"), 0)
+	})
+
+	t.Run("it takes a point off stores which only award points", func(t *testing.T) {
+		database, cleanDatabase := createTempFile(t, `[{"Name": "Pepper", "Wins": 3}]`)
+		defer cleanDatabase()
+
+		fileStore, err := NewFileSystemPlayerStore(database)
+		assertNoError(t, err)
+
+		response := httptest.NewRecorder()
+		NewPlayerServer(fileStore).ServeHTTP(response, newPostLossRequest("Pepper"))
+
+		assertStatus(t, response.Code, http.StatusAccepted)
+		assertScoreEquals(t, fileStore.GetPlayerScore("Pepper"), 2)
+	})
+
+	t.Run("it returns 404 for a player who is not in the store", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newPostLossRequest("Floyd"))
+
+		assertStatus(t, response.Code, http.StatusNotFound)
+
+		if store.GetLeague().Find("Floyd") != nil {
+			t.Errorf("did not expect a loss to add Floyd to the league %v", store.GetLeague())
+		}
+	})
+
+	t.Run("it returns 501 when the store cannot take points away", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		store := StubPlayerStore{league: []Player{{"Pepper", 3}}}
+		NewPlayerServer(&store).ServeHTTP(response, newPostLossRequest("Pepper"))
+
+		assertStatus(t, response.Code, http.StatusNotImplemented)
+	})
 }
 
 func TestLeague(t *testing.T) {
@@ -123,6 +357,248 @@ func TestLeague(t *testing.T) {
 	})
 }
 
+func TestLeagueRangeEndpoint(t *testing.T) {
+	store := NewInMemoryPlayerStore()
+	store.AddPoints("Cleo", 32)
+	store.AddPoints("Chris", 20)
+	store.AddPoints("Tiest", 14)
+	server := NewPlayerServer(store)
+
+	t.Run("it returns players within the range of wins", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newLeagueRangeRequest("14", "20"))
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertContentType(t, response, jsonContentType)
+		assertLeague(t, getLeagueFromResponse(t, response.Body), []Player{{"Chris", 20}, {"Tiest", 14}})
+	})
+
+	t.Run("either bound can be left out", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newLeagueRangeRequest("20", ""))
+
+		assertLeague(t, getLeagueFromResponse(t, response.Body), []Player{{"Cleo", 32}, {"Chris", 20}})
+	})
+
+	t.Run("an inverted range is empty", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newLeagueRangeRequest("20", "14"))
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertLeague(t, getLeagueFromResponse(t, response.Body), []Player{})
+	})
+
+	t.Run("it returns 400 for a bound which is not a number", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newLeagueRangeRequest("many", ""))
+
+		assertStatus(t, response.Code, http.StatusBadRequest)
+	})
+
+	t.Run("it returns 501 when the store cannot find a range", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		NewPlayerServer(&StubPlayerStore{}).ServeHTTP(response, newLeagueRangeRequest("1", "2"))
+
+		assertStatus(t, response.Code, http.StatusNotImplemented)
+	})
+}
+
+func TestLeagueStatsEndpoint(t *testing.T) {
+
+	t.Run("it returns statistics about the league's wins", func(t *testing.T) {
+		league := []Player{
+			{"Cleo", 32},
+			{"Chris", 20},
+			{"Tiest", 14},
+			{"Pepper", 6},
+		}
+
+		store := StubPlayerStore{nil, nil, league}
+		server := NewPlayerServer(&store)
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newLeagueStatsRequest())
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertContentType(t, response, jsonContentType)
+		assertLeagueStats(t, response.Body, LeagueStats{Count: 4, Mean: 18, Median: 17, Max: 32, Min: 6})
+	})
+
+	t.Run("it returns zeros for an empty league", func(t *testing.T) {
+		store := StubPlayerStore{nil, nil, nil}
+		server := NewPlayerServer(&store)
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newLeagueStatsRequest())
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertLeagueStats(t, response.Body, LeagueStats{})
+	})
+}
+
+func TestLeagueTotal(t *testing.T) {
+
+	t.Run("it returns the total wins across the league", func(t *testing.T) {
+		store := NewInMemoryPlayerStore()
+		store.AddPoints("Cleo", 32)
+		store.AddPoints("Chris", 20)
+		server := NewPlayerServer(store)
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newLeagueTotalRequest())
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertContentType(t, response, jsonContentType)
+		assertResponseBody(t, response.Body.String(), "{\"total\":52}\n")
+	})
+
+	t.Run("it returns 501 when the store cannot total wins", func(t *testing.T) {
+		store := StubPlayerStore{}
+		server := NewPlayerServer(&store)
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newLeagueTotalRequest())
+
+		assertStatus(t, response.Code, http.StatusNotImplemented)
+	})
+}
+
+func TestCompare(t *testing.T) {
+	store := StubPlayerStore{
+		map[string]int{
+			"Cleo":   32,
+			"Chris":  20,
+			"Pepper": 20,
+		},
+		nil,
+		nil,
+	}
+	server := NewPlayerServer(&store)
+
+	t.Run("it returns the leader", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newCompareRequest("Chris", "Cleo"))
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertContentType(t, response, jsonContentType)
+		assertComparison(t, response.Body, Comparison{
+			A:      Player{"Chris", 20},
+			B:      Player{"Cleo", 32},
+			Leader: "Cleo",
+		})
+	})
+
+	t.Run("it returns a tie", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newCompareRequest("Chris", "Pepper"))
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertComparison(t, response.Body, Comparison{
+			A:      Player{"Chris", 20},
+			B:      Player{"Pepper", 20},
+			Leader: "tie",
+		})
+	})
+
+	t.Run("it returns 404 naming the missing player", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newCompareRequest("Cleo", "Apollo"))
+
+		assertStatus(t, response.Code, http.StatusNotFound)
+		assertResponseBody(t, response.Body.String(), "player \"Apollo\" not found\n")
+	})
+}
+
+func TestInactive(t *testing.T) {
+	clock := clock.NewFakeClock(time.Date(2019, time.June, 10, 12, 0, 0, 0, time.UTC))
+	store := NewInMemoryPlayerStoreWithClock(clock)
+
+	store.RecordWin("Tiest")
+	clock.Advance(5 * 24 * time.Hour)
+	store.RecordWin("Cleo")
+	clock.Advance(24 * time.Hour)
+	store.RecordWin("Chris")
+	clock.Advance(24 * time.Hour)
+	// Tiest last won 7 days ago, Cleo 2 days ago and Chris yesterday
+
+	server := NewPlayerServer(store, WithClock(clock))
+
+	t.Run("it returns players who have not won within the days, sorted by name", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		// Chris won exactly a day ago, which is not older than a day
+		server.ServeHTTP(response, newInactiveRequest("1"))
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertContentType(t, response, jsonContentType)
+		assertLeague(t, getLeagueFromResponse(t, response.Body), []Player{
+			{"Cleo", 1},
+			{"Tiest", 1},
+		})
+	})
+
+	t.Run("it returns nobody when everyone has played", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newInactiveRequest("7"))
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertLeague(t, getLeagueFromResponse(t, response.Body), []Player{})
+	})
+
+	t.Run("it includes players who never won", func(t *testing.T) {
+		store := StubPlayerStore{league: []Player{{"Pepper", 0}}}
+		server := NewPlayerServer(&lastWinStubPlayerStore{store, nil}, WithClock(clock))
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newInactiveRequest("7"))
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertLeague(t, getLeagueFromResponse(t, response.Body), []Player{{"Pepper", 0}})
+	})
+
+	for _, days := range []string{"", "soon", "-1"} {
+		t.Run(fmt.Sprintf("it returns 400 for days=%q", days), func(t *testing.T) {
+			response := httptest.NewRecorder()
+			server.ServeHTTP(response, newInactiveRequest(days))
+
+			assertStatus(t, response.Code, http.StatusBadRequest)
+		})
+	}
+
+	t.Run("it returns 501 when the store does not track last wins", func(t *testing.T) {
+		server := NewPlayerServer(&StubPlayerStore{})
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newInactiveRequest("7"))
+
+		assertStatus(t, response.Code, http.StatusNotImplemented)
+	})
+}
+
+func TestHealth(t *testing.T) {
+
+	t.Run("it returns ok when the store is reachable", func(t *testing.T) {
+		server := NewPlayerServer(&StubPlayerStore{})
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newHealthRequest())
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertContentType(t, response, jsonContentType)
+		assertResponseBody(t, response.Body.String(), `{"status":"ok"}`+"\n")
+	})
+
+	t.Run("it returns 503 when the store cannot be reached", func(t *testing.T) {
+		server := NewPlayerServer(&UnreachablePlayerStore{})
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newHealthRequest())
+
+		assertStatus(t, response.Code, http.StatusServiceUnavailable)
+		assertContentType(t, response, jsonContentType)
+		assertResponseBody(t, response.Body.String(), `{"status":"degraded"}`+"\n")
+	})
+}
+
 func assertContentType(t *testing.T, response *httptest.ResponseRecorder, want string) {
 	t.Helper()
 	if response.Header().Get("content-type") != want {
@@ -148,6 +624,17 @@ func assertLeague(t *testing.T, got, want []Player) {
 	}
 }
 
+// assertStableLeague gets the league from store many times, as a store that
+// ranges over a map would only sometimes return ties out of order
+func assertStableLeague(t *testing.T, store PlayerStore, want []Player) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		if got := []Player(store.GetLeague()); !reflect.DeepEqual(got, want) {
+			t.Fatalf("on attempt %d got %v want %v", i+1, got, want)
+		}
+	}
+}
+
 func assertStatus(t *testing.T, got, want int) {
 	t.Helper()
 	if got != want {
@@ -160,11 +647,77 @@ func newLeagueRequest() *http.Request {
 	return req
 }
 
+func newLeagueRangeRequest(minWins, maxWins string) *http.Request {
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("/league?minWins=%s&maxWins=%s", minWins, maxWins), nil)
+	return req
+}
+
+func newLeagueStatsRequest() *http.Request {
+	req, _ := http.NewRequest(http.MethodGet, "/league/stats", nil)
+	return req
+}
+
+func assertLeagueStats(t *testing.T, body io.Reader, want LeagueStats) {
+	t.Helper()
+
+	var got LeagueStats
+	if err := json.NewDecoder(body).Decode(&got); err != nil {
+		t.Fatalf("Unable to parse response from server %q into LeagueStats, '%v'", body, err)
+	}
+
+	if got != want {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
+
+func newLeagueTotalRequest() *http.Request {
+	req, _ := http.NewRequest(http.MethodGet, "/league/total", nil)
+	return req
+}
+
+func newCompareRequest(a, b string) *http.Request {
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("/compare?a=%s&b=%s", a, b), nil)
+	return req
+}
+
+func assertComparison(t *testing.T, body io.Reader, want Comparison) {
+	t.Helper()
+
+	var got Comparison
+	if err := json.NewDecoder(body).Decode(&got); err != nil {
+		t.Fatalf("Unable to parse response from server %q into Comparison, '%v'", body, err)
+	}
+
+	if got != want {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
+
+func newInactiveRequest(days string) *http.Request {
+	req, _ := http.NewRequest(http.MethodGet, "/inactive?days="+days, nil)
+	return req
+}
+
+func newPostPointsRequest(name, body string) *http.Request {
+	req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("/players/%s", name), strings.NewReader(body))
+	return req
+}
+
+func newHealthRequest() *http.Request {
+	req, _ := http.NewRequest(http.MethodGet, "/health", nil)
+	return req
+}
+
 func newGetScoreRequest(name string) *http.Request {
 	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("/players/%s", name), nil)
 	return req
 }
 
+func newPostLossRequest(name string) *http.Request {
+	req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("/players/%s/loss", name), nil)
+	return req
+}
+
 func newPostWinRequest(name string) *http.Request {
 	req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("/players/%s", name), nil)
 	return req
@@ -0,0 +1,87 @@
+package main
+
+// ErrCanonicalDoesNotExist means an alias was added for a word not in the dictionary
+const ErrCanonicalDoesNotExist = DictionaryErr("cannot add alias because the canonical word does not exist")
+
+// AliasDictionary is a Dictionary where some words are aliases, such as
+// spelling variants, which share the definition of a canonical word
+type AliasDictionary struct {
+	Dictionary
+	aliases map[string]string
+}
+
+// NewAliasDictionary creates an AliasDictionary holding the words in dict
+func NewAliasDictionary(dict Dictionary) *AliasDictionary {
+	return &AliasDictionary{
+		Dictionary: dict,
+		aliases:    map[string]string{},
+	}
+}
+
+// AddAlias makes alias find the definition of canonical
+func (a *AliasDictionary) AddAlias(alias, canonical string) error {
+	if _, err := a.Dictionary.Search(canonical); err != nil {
+		return ErrCanonicalDoesNotExist
+	}
+
+	if _, err := a.Search(alias); err == nil {
+		return ErrWordExists
+	}
+
+	a.aliases[alias] = canonical
+	return nil
+}
+
+// Add inserts a word and definition into the dictionary. An alias is already a
+// word in the dictionary, so adding it gives ErrWordExists
+func (a *AliasDictionary) Add(word, definition string) error {
+	if _, ok := a.aliases[word]; ok {
+		return ErrWordExists
+	}
+
+	return a.Dictionary.Add(word, definition)
+}
+
+// AddAll adds each of the entries in order like Dictionary.AddAll, treating aliases as existing words
+func (a *AliasDictionary) AddAll(entries []Entry) []error {
+	errs := make([]error, len(entries))
+	for i, entry := range entries {
+		errs[i] = a.Add(entry.Word, entry.Definition)
+	}
+	return errs
+}
+
+// Update changes the definition of a word. Updating an alias changes the
+// definition of its canonical word, which every one of its aliases shares
+func (a *AliasDictionary) Update(word, definition string) error {
+	if canonical, ok := a.aliases[word]; ok {
+		word = canonical
+	}
+
+	return a.Dictionary.Update(word, definition)
+}
+
+// Search finds a word in the dictionary, following it to its canonical word if it is an alias
+func (a *AliasDictionary) Search(word string) (string, error) {
+	if canonical, ok := a.aliases[word]; ok {
+		word = canonical
+	}
+
+	return a.Dictionary.Search(word)
+}
+
+// Delete removes a word from the dictionary. Deleting a canonical word also removes its aliases
+func (a *AliasDictionary) Delete(word string) {
+	if _, ok := a.aliases[word]; ok {
+		delete(a.aliases, word)
+		return
+	}
+
+	a.Dictionary.Delete(word)
+
+	for alias, canonical := range a.aliases {
+		if canonical == word {
+			delete(a.aliases, alias)
+		}
+	}
+}
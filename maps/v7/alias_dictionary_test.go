@@ -0,0 +1,94 @@
+package main
+
+import "testing"
+
+func TestAliasDictionary(t *testing.T) {
+	t.Run("alias finds the canonical definition", func(t *testing.T) {
+		dictionary := NewAliasDictionary(Dictionary{"colour": "the appearance of light"})
+
+		err := dictionary.AddAlias("color", "colour")
+		assertError(t, err, nil)
+
+		assertDefinition(t, dictionary, "color", "the appearance of light")
+	})
+
+	t.Run("canonical word must exist", func(t *testing.T) {
+		dictionary := NewAliasDictionary(Dictionary{})
+
+		err := dictionary.AddAlias("color", "colour")
+		assertError(t, err, ErrCanonicalDoesNotExist)
+	})
+
+	t.Run("alias cannot replace an existing word", func(t *testing.T) {
+		dictionary := NewAliasDictionary(Dictionary{
+			"colour": "the appearance of light",
+			"color":  "a different definition",
+		})
+
+		err := dictionary.AddAlias("color", "colour")
+		assertError(t, err, ErrWordExists)
+	})
+
+	t.Run("deleting the canonical word invalidates its aliases", func(t *testing.T) {
+		dictionary := NewAliasDictionary(Dictionary{"colour": "the appearance of light"})
+		dictionary.AddAlias("color", "colour")
+
+		dictionary.Delete("colour")
+
+		_, err := dictionary.Search("color")
+		assertError(t, err, ErrNotFound)
+
+		// re-adding the word should not bring the old alias back
+		dictionary.Add("colour", "the appearance of light")
+		_, err = dictionary.Search("color")
+		assertError(t, err, ErrNotFound)
+	})
+
+	t.Run("deleting an alias keeps the canonical word", func(t *testing.T) {
+		dictionary := NewAliasDictionary(Dictionary{"colour": "the appearance of light"})
+		dictionary.AddAlias("color", "colour")
+
+		dictionary.Delete("color")
+
+		_, err := dictionary.Search("color")
+		assertError(t, err, ErrNotFound)
+		assertDefinition(t, dictionary, "colour", "the appearance of light")
+	})
+
+	t.Run("deleting a canonical word keeps other words' aliases", func(t *testing.T) {
+		dictionary := NewAliasDictionary(Dictionary{
+			"colour": "the appearance of light",
+			"grey":   "between black and white",
+		})
+		dictionary.AddAlias("color", "colour")
+		dictionary.AddAlias("gray", "grey")
+
+		dictionary.Delete("colour")
+
+		assertDefinition(t, dictionary, "gray", "between black and white")
+	})
+
+	t.Run("an alias cannot be added as a word", func(t *testing.T) {
+		dictionary := NewAliasDictionary(Dictionary{"colour": "the appearance of light"})
+		dictionary.AddAlias("color", "colour")
+
+		err := dictionary.Add("color", "a different definition")
+		assertError(t, err, ErrWordExists)
+
+		errs := dictionary.AddAll([]Entry{{"color", "a different definition"}})
+		assertError(t, errs[0], ErrWordExists)
+
+		assertDefinition(t, dictionary, "color", "the appearance of light")
+	})
+
+	t.Run("updating an alias updates its canonical word", func(t *testing.T) {
+		dictionary := NewAliasDictionary(Dictionary{"colour": "the appearance of light"})
+		dictionary.AddAlias("color", "colour")
+
+		err := dictionary.Update("color", "how light looks")
+		assertError(t, err, nil)
+
+		assertDefinition(t, dictionary, "colour", "how light looks")
+		assertDefinition(t, dictionary, "color", "how light looks")
+	})
+}
@@ -1,5 +1,11 @@
 package main
 
+import (
+	"math/rand"
+	"sort"
+	"unicode/utf8"
+)
+
 const (
 	// ErrNotFound means the definition could not be found for the given word
 	ErrNotFound = DictionaryErr("could not find the word you were looking for")
@@ -9,6 +15,9 @@ const (
 
 	// ErrWordDoesNotExist occurs when trying to update a word not in the dictionary
 	ErrWordDoesNotExist = DictionaryErr("cannot update word because it does not exist")
+
+	// ErrEmptyDictionary means there are no words to choose from
+	ErrEmptyDictionary = DictionaryErr("cannot pick a word from an empty dictionary")
 )
 
 // DictionaryErr are errors that can happen when interacting with the dictionary
@@ -47,6 +56,23 @@ func (d Dictionary) Add(word, definition string) error {
 	return nil
 }
 
+// Entry is a word and its definition
+type Entry struct {
+	Word       string `json:"word"`
+	Definition string `json:"definition"`
+}
+
+// AddAll adds each of the entries in order, returning the result of each Add.
+// Entries which conflict with a word already in the dictionary, including one
+// added earlier in the same batch, get ErrWordExists and do not stop the rest
+func (d Dictionary) AddAll(entries []Entry) []error {
+	errs := make([]error, len(entries))
+	for i, entry := range entries {
+		errs[i] = d.Add(entry.Word, entry.Definition)
+	}
+	return errs
+}
+
 // Update changes the definition of a given word
 func (d Dictionary) Update(word, definition string) error {
 	_, err := d.Search(word)
@@ -67,3 +93,88 @@ func (d Dictionary) Update(word, definition string) error {
 func (d Dictionary) Delete(word string) {
 	delete(d, word)
 }
+
+// RandomWord picks a word and its definition from dict uniformly at random using rng
+func RandomWord(dict map[string]string, rng *rand.Rand) (word, def string, err error) {
+	if len(dict) == 0 {
+		return "", "", ErrEmptyDictionary
+	}
+
+	words := make([]string, 0, len(dict))
+	for w := range dict {
+		words = append(words, w)
+	}
+	// map iteration order is random, sorting means the same rng always picks the same word
+	sort.Strings(words)
+
+	word = words[rng.Intn(len(words))]
+	return word, dict[word], nil
+}
+
+// SampleEntries picks up to n distinct words and their definitions from dict
+// at random using rng. If n is more than the number of words, all of them are returned
+func SampleEntries(dict map[string]string, n int, rng *rand.Rand) map[string]string {
+	words := make([]string, 0, len(dict))
+	for w := range dict {
+		words = append(words, w)
+	}
+	// as with RandomWord, sorting means the same rng always picks the same words
+	sort.Strings(words)
+
+	rng.Shuffle(len(words), func(i, j int) {
+		words[i], words[j] = words[j], words[i]
+	})
+
+	if n > len(words) {
+		n = len(words)
+	}
+	if n < 0 {
+		n = 0
+	}
+
+	sample := make(map[string]string)
+	for _, word := range words[:n] {
+		sample[word] = dict[word]
+	}
+	return sample
+}
+
+// LongestDefinition finds the word with the most runes in its definition.
+// Ties go to the word which comes first alphabetically
+func LongestDefinition(dict map[string]string) (word, def string, ok bool) {
+	return findDefinition(dict, func(length, best int) bool { return length > best })
+}
+
+// ShortestDefinition finds the word with the fewest runes in its definition.
+// Ties go to the word which comes first alphabetically
+func ShortestDefinition(dict map[string]string) (word, def string, ok bool) {
+	return findDefinition(dict, func(length, best int) bool { return length < best })
+}
+
+func findDefinition(dict map[string]string, better func(length, best int) bool) (word, def string, ok bool) {
+	bestLength := 0
+
+	for w, d := range dict {
+		length := utf8.RuneCountInString(d)
+
+		if !ok || better(length, bestLength) || (length == bestLength && w < word) {
+			word, def, bestLength, ok = w, d, length, true
+		}
+	}
+
+	return word, def, ok
+}
+
+// ForEachSorted calls fn with each word and its definition in alphabetical order of the words.
+// Only the words are collected and sorted, definitions are looked up as fn is called
+func ForEachSorted(dict map[string]string, fn func(word, def string)) {
+	words := make([]string, 0, len(dict))
+	for word := range dict {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+
+	for _, word := range words {
+		fn(word, dict[word])
+	}
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"math/rand"
+	"reflect"
 	"testing"
 )
 
@@ -44,6 +46,22 @@ func TestAdd(t *testing.T) {
 	})
 }
 
+func TestAddAll(t *testing.T) {
+	dictionary := Dictionary{"test": "this is just a test"}
+
+	errs := dictionary.AddAll([]Entry{
+		{Word: "cat", Definition: "a small animal"},
+		{Word: "test", Definition: "a new definition"},
+		{Word: "cat", Definition: "a different animal"},
+	})
+
+	assertError(t, errs[0], nil)
+	assertError(t, errs[1], ErrWordExists)
+	assertError(t, errs[2], ErrWordExists)
+	assertDefinition(t, dictionary, "cat", "a small animal")
+	assertDefinition(t, dictionary, "test", "this is just a test")
+}
+
 func TestUpdate(t *testing.T) {
 	t.Run("existing word", func(t *testing.T) {
 		word := "test"
@@ -79,6 +97,135 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestRandomWord(t *testing.T) {
+	t.Run("picks a word using the rng", func(t *testing.T) {
+		dictionary := Dictionary{
+			"apple":  "a fruit",
+			"banana": "a yellow fruit",
+			"cherry": "a small red fruit",
+		}
+		rng := rand.New(rand.NewSource(1))
+		want := "cherry"
+
+		word, definition, err := RandomWord(dictionary, rng)
+
+		assertError(t, err, nil)
+		assertStrings(t, word, want)
+		assertStrings(t, definition, dictionary[want])
+	})
+
+	t.Run("empty dictionary", func(t *testing.T) {
+		_, _, err := RandomWord(Dictionary{}, rand.New(rand.NewSource(42)))
+
+		assertError(t, err, ErrEmptyDictionary)
+	})
+}
+
+func TestSampleEntries(t *testing.T) {
+	dictionary := Dictionary{
+		"apple":  "a fruit",
+		"banana": "a yellow fruit",
+		"cherry": "a small red fruit",
+		"date":   "a sweet fruit",
+		"elder":  "a berry",
+	}
+
+	t.Run("picks n entries from the dictionary", func(t *testing.T) {
+		sample := SampleEntries(dictionary, 3, rand.New(rand.NewSource(1)))
+
+		if len(sample) != 3 {
+			t.Fatalf("got %d entries want 3, %v", len(sample), sample)
+		}
+
+		for word, definition := range sample {
+			assertStrings(t, definition, dictionary[word])
+		}
+	})
+
+	t.Run("the same seed picks the same entries", func(t *testing.T) {
+		first := SampleEntries(dictionary, 3, rand.New(rand.NewSource(1)))
+		second := SampleEntries(dictionary, 3, rand.New(rand.NewSource(1)))
+
+		if !reflect.DeepEqual(first, second) {
+			t.Errorf("got %v then %v from the same seed", first, second)
+		}
+	})
+
+	t.Run("asking for more entries than there are returns them all", func(t *testing.T) {
+		sample := SampleEntries(dictionary, 10, rand.New(rand.NewSource(1)))
+
+		if !reflect.DeepEqual(sample, map[string]string(dictionary)) {
+			t.Errorf("got %v want %v", sample, dictionary)
+		}
+	})
+}
+
+func TestLongestAndShortestDefinition(t *testing.T) {
+	dictionary := Dictionary{
+		"cat":   "a small animal",
+		"ant":   "an insect",
+		"zebra": "a striped horse",
+		"yak":   "a shaggy animal",
+		"tea":   "☕☕☕☕☕☕",
+	}
+
+	t.Run("longest breaks ties alphabetically", func(t *testing.T) {
+		word, definition, ok := LongestDefinition(dictionary)
+
+		assertFound(t, ok, true)
+		assertStrings(t, word, "yak")
+		assertStrings(t, definition, "a shaggy animal")
+	})
+
+	t.Run("shortest counts runes not bytes", func(t *testing.T) {
+		// six cups of tea are 18 bytes but only 6 runes
+		word, definition, ok := ShortestDefinition(dictionary)
+
+		assertFound(t, ok, true)
+		assertStrings(t, word, "tea")
+		assertStrings(t, definition, "☕☕☕☕☕☕")
+	})
+
+	t.Run("empty dictionary", func(t *testing.T) {
+		_, _, ok := LongestDefinition(Dictionary{})
+		assertFound(t, ok, false)
+
+		_, _, ok = ShortestDefinition(Dictionary{})
+		assertFound(t, ok, false)
+	})
+}
+
+func TestForEachSorted(t *testing.T) {
+	dictionary := Dictionary{
+		"cherry": "a small red fruit",
+		"apple":  "a fruit",
+		"banana": "a yellow fruit",
+	}
+
+	var got []string
+	ForEachSorted(dictionary, func(word, def string) {
+		got = append(got, word+": "+def)
+	})
+
+	want := []string{
+		"apple: a fruit",
+		"banana: a yellow fruit",
+		"cherry: a small red fruit",
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q want %q", got, want)
+	}
+}
+
+func assertFound(t *testing.T, got, want bool) {
+	t.Helper()
+
+	if got != want {
+		t.Errorf("got ok %t want %t", got, want)
+	}
+}
+
 func assertStrings(t *testing.T, got, want string) {
 	t.Helper()
 
@@ -95,7 +242,11 @@ func assertError(t *testing.T, got, want error) {
 	}
 }
 
-func assertDefinition(t *testing.T, dictionary Dictionary, word, definition string) {
+type searcher interface {
+	Search(word string) (string, error)
+}
+
+func assertDefinition(t *testing.T, dictionary searcher, word, definition string) {
 	t.Helper()
 
 	got, err := dictionary.Search(word)
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"time"
+
+	"github.com/quii/learn-go-with-tests/internal/clock"
+)
+
+type expiringDefinition struct {
+	definition string
+	expiresAt  time.Time
+}
+
+// ExpiringDictionary forgets definitions once their time to live has passed
+type ExpiringDictionary struct {
+	entries map[string]expiringDefinition
+	clock   clock.Clock
+}
+
+// NewExpiringDictionary creates an ExpiringDictionary which uses clock to tell whether entries have expired
+func NewExpiringDictionary(clock clock.Clock) *ExpiringDictionary {
+	return &ExpiringDictionary{
+		entries: map[string]expiringDefinition{},
+		clock:   clock,
+	}
+}
+
+// AddWithTTL inserts a word and definition which will expire after ttl
+func (e *ExpiringDictionary) AddWithTTL(word, definition string, ttl time.Duration) error {
+	_, err := e.Search(word)
+	switch err {
+	case ErrNotFound:
+		e.entries[word] = expiringDefinition{definition, e.clock.Now().Add(ttl)}
+	case nil:
+		return ErrWordExists
+	default:
+		return err
+	}
+
+	return nil
+}
+
+// Search finds a word in the dictionary, removing it if it has expired
+func (e *ExpiringDictionary) Search(word string) (string, error) {
+	entry, ok := e.entries[word]
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	if e.expired(entry) {
+		delete(e.entries, word)
+		return "", ErrNotFound
+	}
+
+	return entry.definition, nil
+}
+
+// Sweep removes every expired entry, returning how many were removed
+func (e *ExpiringDictionary) Sweep() int {
+	removed := 0
+	for word, entry := range e.entries {
+		if e.expired(entry) {
+			delete(e.entries, word)
+			removed++
+		}
+	}
+	return removed
+}
+
+func (e *ExpiringDictionary) expired(entry expiringDefinition) bool {
+	return !e.clock.Now().Before(entry.expiresAt)
+}
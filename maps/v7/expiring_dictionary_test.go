@@ -0,0 +1,80 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/quii/learn-go-with-tests/internal/clock"
+)
+
+func TestExpiringDictionary(t *testing.T) {
+	newClock := func() *clock.FakeClock {
+		return clock.NewFakeClock(time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC))
+	}
+
+	t.Run("finds words before they expire", func(t *testing.T) {
+		clock := newClock()
+		dictionary := NewExpiringDictionary(clock)
+
+		err := dictionary.AddWithTTL("test", "this is just a test", time.Minute)
+		assertError(t, err, nil)
+
+		clock.Advance(59 * time.Second)
+
+		got, err := dictionary.Search("test")
+		assertError(t, err, nil)
+		assertStrings(t, got, "this is just a test")
+	})
+
+	t.Run("forgets words once they expire", func(t *testing.T) {
+		clock := newClock()
+		dictionary := NewExpiringDictionary(clock)
+
+		dictionary.AddWithTTL("test", "this is just a test", time.Minute)
+		clock.Advance(time.Minute)
+
+		_, err := dictionary.Search("test")
+		assertError(t, err, ErrNotFound)
+	})
+
+	t.Run("cannot add a word which has not expired", func(t *testing.T) {
+		clock := newClock()
+		dictionary := NewExpiringDictionary(clock)
+
+		dictionary.AddWithTTL("test", "this is just a test", time.Minute)
+
+		err := dictionary.AddWithTTL("test", "new test", time.Minute)
+		assertError(t, err, ErrWordExists)
+	})
+
+	t.Run("can add a word again once it expires", func(t *testing.T) {
+		clock := newClock()
+		dictionary := NewExpiringDictionary(clock)
+
+		dictionary.AddWithTTL("test", "this is just a test", time.Minute)
+		clock.Advance(time.Minute)
+
+		err := dictionary.AddWithTTL("test", "new test", time.Minute)
+		assertError(t, err, nil)
+
+		got, _ := dictionary.Search("test")
+		assertStrings(t, got, "new test")
+	})
+
+	t.Run("sweep removes expired words", func(t *testing.T) {
+		clock := newClock()
+		dictionary := NewExpiringDictionary(clock)
+
+		dictionary.AddWithTTL("short", "gone soon", time.Minute)
+		dictionary.AddWithTTL("long", "here for a while", time.Hour)
+		clock.Advance(2 * time.Minute)
+
+		if removed := dictionary.Sweep(); removed != 1 {
+			t.Errorf("got %d words swept want 1", removed)
+		}
+
+		if len(dictionary.entries) != 1 {
+			t.Errorf("got %d entries left want 1", len(dictionary.entries))
+		}
+	})
+}
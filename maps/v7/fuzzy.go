@@ -0,0 +1,73 @@
+package main
+
+import "sort"
+
+// FuzzySearch returns the words in dict within maxDistance edits of word,
+// closest first and alphabetically when they are as close as each other
+func FuzzySearch(dict map[string]string, word string, maxDistance int) []string {
+	return fuzzySearch(dict, word, maxDistance, func(string) int { return 0 })
+}
+
+// fuzzySearch does the work for FuzzySearch, ranking words which are as close
+// as each other by weight, highest first, before alphabetically
+func fuzzySearch(dict map[string]string, word string, maxDistance int, weight func(word string) int) []string {
+	distances := make(map[string]int)
+	var matches []string
+
+	for candidate := range dict {
+		distance := editDistance(word, candidate)
+		if distance <= maxDistance {
+			distances[candidate] = distance
+			matches = append(matches, candidate)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if distances[a] != distances[b] {
+			return distances[a] < distances[b]
+		}
+		if weight(a) != weight(b) {
+			return weight(a) > weight(b)
+		}
+		return a < b
+	})
+
+	return matches
+}
+
+// editDistance is the Levenshtein distance between a and b, the fewest
+// single rune insertions, deletions or substitutions to turn one into the other
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = minimum(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
+
+func minimum(values ...int) int {
+	smallest := values[0]
+	for _, v := range values[1:] {
+		if v < smallest {
+			smallest = v
+		}
+	}
+	return smallest
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFuzzySearch(t *testing.T) {
+	dictionary := Dictionary{
+		"test":  "this is just a test",
+		"text":  "written words",
+		"tent":  "a shelter",
+		"taste": "a flavour",
+		"zebra": "a striped horse",
+	}
+
+	t.Run("closest words first, then alphabetically", func(t *testing.T) {
+		got := FuzzySearch(dictionary, "tesk", 2)
+		want := []string{"test", "tent", "text"}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %q want %q", got, want)
+		}
+	})
+
+	t.Run("nothing close enough", func(t *testing.T) {
+		got := FuzzySearch(dictionary, "quux", 1)
+
+		if len(got) != 0 {
+			t.Errorf("expected no matches but got %q", got)
+		}
+	})
+}
+
+func TestEditDistance(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"test", "test", 0},
+		{"test", "", 4},
+		{"kitten", "sitting", 3},
+		{"café", "cafe", 1},
+	}
+
+	for _, c := range cases {
+		if got := editDistance(c.a, c.b); got != c.want {
+			t.Errorf("distance between %q and %q got %d want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
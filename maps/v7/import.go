@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ConflictStrategy decides what happens when an imported word is already in the dictionary
+type ConflictStrategy int
+
+const (
+	// ConflictSkip keeps the existing definition
+	ConflictSkip ConflictStrategy = iota
+
+	// ConflictOverwrite replaces the existing definition with the imported one
+	ConflictOverwrite
+
+	// ConflictError keeps the existing definition and reports an ImportConflictErr
+	ConflictError
+)
+
+// ImportConflictErr is reported when an imported word already exists and the strategy is ConflictError
+type ImportConflictErr struct {
+	Word string
+}
+
+func (e ImportConflictErr) Error() string {
+	return fmt.Sprintf("cannot import %q because it already exists", e.Word)
+}
+
+// ImportWithStrategy adds entries to dict, using strategy to resolve words that already exist.
+// Any conflicts reported are in alphabetical order of the word
+func ImportWithStrategy(dict map[string]string, entries map[string]string, strategy ConflictStrategy) []error {
+	words := make([]string, 0, len(entries))
+	for word := range entries {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+
+	var errs []error
+	for _, word := range words {
+		if _, exists := dict[word]; exists {
+			switch strategy {
+			case ConflictSkip:
+				continue
+			case ConflictError:
+				errs = append(errs, ImportConflictErr{word})
+				continue
+			}
+		}
+		dict[word] = entries[word]
+	}
+
+	return errs
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestImportWithStrategy(t *testing.T) {
+	newDictionary := func() Dictionary {
+		return Dictionary{
+			"apple":  "a fruit",
+			"banana": "a yellow fruit",
+		}
+	}
+
+	entries := map[string]string{
+		"banana": "a long fruit",
+		"cherry": "a small red fruit",
+	}
+
+	t.Run("skip keeps existing definitions", func(t *testing.T) {
+		dictionary := newDictionary()
+
+		errs := ImportWithStrategy(dictionary, entries, ConflictSkip)
+
+		assertNoErrors(t, errs)
+		assertDefinition(t, dictionary, "banana", "a yellow fruit")
+		assertDefinition(t, dictionary, "cherry", "a small red fruit")
+	})
+
+	t.Run("overwrite replaces existing definitions", func(t *testing.T) {
+		dictionary := newDictionary()
+
+		errs := ImportWithStrategy(dictionary, entries, ConflictOverwrite)
+
+		assertNoErrors(t, errs)
+		assertDefinition(t, dictionary, "banana", "a long fruit")
+		assertDefinition(t, dictionary, "cherry", "a small red fruit")
+	})
+
+	t.Run("error records conflicts and keeps existing definitions", func(t *testing.T) {
+		dictionary := newDictionary()
+
+		errs := ImportWithStrategy(dictionary, entries, ConflictError)
+
+		want := []error{ImportConflictErr{"banana"}}
+		if !reflect.DeepEqual(errs, want) {
+			t.Errorf("got errors %v want %v", errs, want)
+		}
+		assertDefinition(t, dictionary, "banana", "a yellow fruit")
+		assertDefinition(t, dictionary, "cherry", "a small red fruit")
+	})
+}
+
+func assertNoErrors(t *testing.T, errs []error) {
+	t.Helper()
+
+	if len(errs) != 0 {
+		t.Errorf("expected no errors but got %v", errs)
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+// DefinitionLoader looks up the definition of a word from somewhere outside of memory
+type DefinitionLoader func(word string) (string, bool)
+
+// LazyDictionary only holds the definitions it has been asked for, loading the rest on demand
+type LazyDictionary struct {
+	cache  Dictionary
+	loader DefinitionLoader
+}
+
+// NewLazyDictionary creates a LazyDictionary which uses loader for words it has not seen yet
+func NewLazyDictionary(loader DefinitionLoader) *LazyDictionary {
+	return &LazyDictionary{
+		cache:  Dictionary{},
+		loader: loader,
+	}
+}
+
+// Search finds a word in the cache, falling back to the loader and caching what it finds
+func (l *LazyDictionary) Search(word string) (string, error) {
+	if definition, err := l.cache.Search(word); err == nil {
+		return definition, nil
+	}
+
+	definition, ok := l.loader(word)
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	l.cache[word] = definition
+	return definition, nil
+}
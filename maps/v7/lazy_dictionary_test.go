@@ -0,0 +1,59 @@
+package main
+
+import "testing"
+
+type spyLoader struct {
+	source Dictionary
+	calls  map[string]int
+}
+
+func (s *spyLoader) Load(word string) (string, bool) {
+	s.calls[word]++
+	definition, ok := s.source[word]
+	return definition, ok
+}
+
+func TestLazyDictionary(t *testing.T) {
+	newSpyLoader := func() *spyLoader {
+		return &spyLoader{
+			source: Dictionary{"test": "this is just a test"},
+			calls:  map[string]int{},
+		}
+	}
+
+	t.Run("loads a word once and caches it", func(t *testing.T) {
+		loader := newSpyLoader()
+		dictionary := NewLazyDictionary(loader.Load)
+
+		for i := 0; i < 3; i++ {
+			got, err := dictionary.Search("test")
+
+			assertError(t, err, nil)
+			assertStrings(t, got, "this is just a test")
+		}
+
+		assertLoads(t, loader, "test", 1)
+	})
+
+	t.Run("unknown word", func(t *testing.T) {
+		loader := newSpyLoader()
+		dictionary := NewLazyDictionary(loader.Load)
+
+		_, err := dictionary.Search("unknown")
+		assertError(t, err, ErrNotFound)
+
+		_, err = dictionary.Search("unknown")
+		assertError(t, err, ErrNotFound)
+
+		// misses are not cached, so the loader is asked each time
+		assertLoads(t, loader, "unknown", 2)
+	})
+}
+
+func assertLoads(t *testing.T, loader *spyLoader, word string, want int) {
+	t.Helper()
+
+	if got := loader.calls[word]; got != want {
+		t.Errorf("loaded %q %d times want %d", word, got, want)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// DefinitionTooLongError is returned when a definition has more runes than a LimitedDictionary allows
+type DefinitionTooLongError struct {
+	Word   string
+	Length int
+	Max    int
+}
+
+func (e DefinitionTooLongError) Error() string {
+	return fmt.Sprintf("definition of %q is %d characters long, the most allowed is %d", e.Word, e.Length, e.Max)
+}
+
+// LimitedDictionary is a Dictionary which rejects definitions longer than a maximum number of runes
+type LimitedDictionary struct {
+	Dictionary
+	maxLength int
+}
+
+// NewLimitedDictionary creates a LimitedDictionary holding the words in dict
+// which allows definitions of up to maxLength runes
+func NewLimitedDictionary(dict Dictionary, maxLength int) *LimitedDictionary {
+	return &LimitedDictionary{
+		Dictionary: dict,
+		maxLength:  maxLength,
+	}
+}
+
+// Add inserts a word and definition into the dictionary, as long as the definition is not too long
+func (l *LimitedDictionary) Add(word, definition string) error {
+	if err := l.checkLength(word, definition); err != nil {
+		return err
+	}
+
+	return l.Dictionary.Add(word, definition)
+}
+
+// Update changes the definition of a given word, as long as the new definition is not too long
+func (l *LimitedDictionary) Update(word, definition string) error {
+	if err := l.checkLength(word, definition); err != nil {
+		return err
+	}
+
+	return l.Dictionary.Update(word, definition)
+}
+
+// AddAll adds each of the entries in order like Dictionary.AddAll, rejecting definitions which are too long
+func (l *LimitedDictionary) AddAll(entries []Entry) []error {
+	errs := make([]error, len(entries))
+	for i, entry := range entries {
+		errs[i] = l.Add(entry.Word, entry.Definition)
+	}
+	return errs
+}
+
+func (l *LimitedDictionary) checkLength(word, definition string) error {
+	if length := utf8.RuneCountInString(definition); length > l.maxLength {
+		return DefinitionTooLongError{word, length, l.maxLength}
+	}
+	return nil
+}
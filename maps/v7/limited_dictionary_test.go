@@ -0,0 +1,54 @@
+package main
+
+import "testing"
+
+func TestLimitedDictionary(t *testing.T) {
+	t.Run("definition under the limit", func(t *testing.T) {
+		dictionary := NewLimitedDictionary(Dictionary{}, 10)
+
+		assertError(t, dictionary.Add("cat", "a pet"), nil)
+		assertDefinition(t, dictionary, "cat", "a pet")
+	})
+
+	t.Run("definition exactly at the limit", func(t *testing.T) {
+		dictionary := NewLimitedDictionary(Dictionary{}, 6)
+
+		// six cups of tea are 18 bytes but only 6 runes
+		assertError(t, dictionary.Add("tea", "☕☕☕☕☕☕"), nil)
+		assertDefinition(t, dictionary, "tea", "☕☕☕☕☕☕")
+	})
+
+	t.Run("definition over the limit", func(t *testing.T) {
+		dictionary := NewLimitedDictionary(Dictionary{}, 10)
+
+		err := dictionary.Add("test", "this is just a test")
+
+		assertError(t, err, DefinitionTooLongError{Word: "test", Length: 19, Max: 10})
+		_, err = dictionary.Search("test")
+		assertError(t, err, ErrNotFound)
+	})
+
+	t.Run("updates are limited too", func(t *testing.T) {
+		dictionary := NewLimitedDictionary(Dictionary{"cat": "a pet"}, 10)
+
+		err := dictionary.Update("cat", "a small furry animal")
+
+		assertError(t, err, DefinitionTooLongError{Word: "cat", Length: 20, Max: 10})
+		assertDefinition(t, dictionary, "cat", "a pet")
+	})
+
+	t.Run("bulk adds are limited too", func(t *testing.T) {
+		dictionary := NewLimitedDictionary(Dictionary{}, 10)
+
+		errs := dictionary.AddAll([]Entry{
+			{"cat", "a pet"},
+			{"test", "this is just a test"},
+		})
+
+		assertError(t, errs[0], nil)
+		assertError(t, errs[1], DefinitionTooLongError{Word: "test", Length: 19, Max: 10})
+		assertDefinition(t, dictionary, "cat", "a pet")
+		_, err := dictionary.Search("test")
+		assertError(t, err, ErrNotFound)
+	})
+}
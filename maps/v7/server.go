@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+const jsonContentType = "application/json"
+
+// DefineResult is what happened to one entry POSTed to /define, its Result is "added" or "conflict"
+type DefineResult struct {
+	Word   string `json:"word"`
+	Result string `json:"result"`
+}
+
+// DictionaryServer is a HTTP interface to a Dictionary
+type DictionaryServer struct {
+	mu         sync.Mutex
+	dictionary Dictionary
+	http.Handler
+}
+
+// NewDictionaryServer creates a DictionaryServer with routing configured
+func NewDictionaryServer(dictionary Dictionary) *DictionaryServer {
+	d := &DictionaryServer{dictionary: dictionary}
+
+	router := http.NewServeMux()
+	router.Handle("/define", http.HandlerFunc(d.defineHandler))
+
+	d.Handler = router
+
+	return d
+}
+
+// defineHandler adds a JSON array of entries to the dictionary with AddAll,
+// responding with the result of each entry in the same order
+func (d *DictionaryServer) defineHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "can only POST to /define", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var entries []Entry
+	if err := json.NewDecoder(r.Body).Decode(&entries); err != nil {
+		http.Error(w, fmt.Sprintf("problem parsing entries, %v", err), http.StatusBadRequest)
+		return
+	}
+
+	d.mu.Lock()
+	errs := d.dictionary.AddAll(entries)
+	d.mu.Unlock()
+
+	results := make([]DefineResult, len(entries))
+	for i, entry := range entries {
+		results[i] = DefineResult{Word: entry.Word, Result: "added"}
+		if errs[i] != nil {
+			results[i].Result = "conflict"
+		}
+	}
+
+	w.Header().Set("content-type", jsonContentType)
+	json.NewEncoder(w).Encode(results)
+}
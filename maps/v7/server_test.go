@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDefine(t *testing.T) {
+	t.Run("adds a batch of new words", func(t *testing.T) {
+		dictionary := Dictionary{}
+		server := NewDictionaryServer(dictionary)
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newDefineRequest(`[
+			{"word": "test", "definition": "this is just a test"},
+			{"word": "cat", "definition": "a small animal"}]`))
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertDefineResults(t, response.Body, []DefineResult{
+			{Word: "test", Result: "added"},
+			{Word: "cat", Result: "added"},
+		})
+		assertDefinition(t, dictionary, "test", "this is just a test")
+		assertDefinition(t, dictionary, "cat", "a small animal")
+	})
+
+	t.Run("reports conflicts and keeps the existing definition", func(t *testing.T) {
+		dictionary := Dictionary{"test": "this is just a test"}
+		server := NewDictionaryServer(dictionary)
+
+		response := httptest.NewRecorder()
+		server.ServeHTTP(response, newDefineRequest(`[
+			{"word": "test", "definition": "a new definition"},
+			{"word": "cat", "definition": "a small animal"}]`))
+
+		assertStatus(t, response.Code, http.StatusOK)
+		assertDefineResults(t, response.Body, []DefineResult{
+			{Word: "test", Result: "conflict"},
+			{Word: "cat", Result: "added"},
+		})
+		assertDefinition(t, dictionary, "test", "this is just a test")
+	})
+
+	t.Run("rejects a body which is not JSON", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		NewDictionaryServer(Dictionary{}).ServeHTTP(response, newDefineRequest("test"))
+
+		assertStatus(t, response.Code, http.StatusBadRequest)
+	})
+
+	t.Run("only accepts POST", func(t *testing.T) {
+		request, _ := http.NewRequest(http.MethodGet, "/define", nil)
+		response := httptest.NewRecorder()
+		NewDictionaryServer(Dictionary{}).ServeHTTP(response, request)
+
+		assertStatus(t, response.Code, http.StatusMethodNotAllowed)
+	})
+}
+
+func newDefineRequest(body string) *http.Request {
+	request, _ := http.NewRequest(http.MethodPost, "/define", strings.NewReader(body))
+	return request
+}
+
+func assertDefineResults(t *testing.T, body io.Reader, want []DefineResult) {
+	t.Helper()
+
+	var got []DefineResult
+	if err := json.NewDecoder(body).Decode(&got); err != nil {
+		t.Fatalf("Unable to parse response from server into DefineResults, '%v'", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v want %+v", got, want)
+	}
+}
+
+func assertStatus(t *testing.T, got, want int) {
+	t.Helper()
+	if got != want {
+		t.Errorf("did not get correct status, got %d, want %d", got, want)
+	}
+}
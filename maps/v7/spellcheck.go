@@ -0,0 +1,68 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// suggestionDistance is how many edits away a word can be to be suggested as a correction
+const suggestionDistance = 2
+
+// Misspelling is a word in some text which is not in the dictionary.
+// Pos is the byte offset of the word in the text
+type Misspelling struct {
+	Word        string
+	Pos         int
+	Suggestions []string
+}
+
+// SpellCheck finds the words in text which are not in dict, suggesting known words close to each.
+// Punctuation around words is ignored, as is case
+func SpellCheck(dict map[string]string, text string) []Misspelling {
+	var misspellings []Misspelling
+
+	for _, token := range tokenize(text) {
+		word := strings.ToLower(token.Word)
+		if _, known := dict[word]; known {
+			continue
+		}
+
+		token.Suggestions = FuzzySearch(dict, word, suggestionDistance)
+		misspellings = append(misspellings, token)
+	}
+
+	return misspellings
+}
+
+// tokenize splits text on whitespace, trimming punctuation from each word
+func tokenize(text string) []Misspelling {
+	var tokens []Misspelling
+
+	start := -1
+	for i, r := range text + " " {
+		if !unicode.IsSpace(r) {
+			if start == -1 {
+				start = i
+			}
+			continue
+		}
+
+		if start != -1 {
+			if token, ok := trimToken(text[start:i], start); ok {
+				tokens = append(tokens, token)
+			}
+			start = -1
+		}
+	}
+
+	return tokens
+}
+
+func trimToken(field string, pos int) (Misspelling, bool) {
+	word := strings.TrimLeftFunc(field, unicode.IsPunct)
+	pos += len(field) - len(word)
+	word = strings.TrimRightFunc(word, unicode.IsPunct)
+
+	return Misspelling{Word: word, Pos: pos}, utf8.RuneCountInString(word) > 0
+}
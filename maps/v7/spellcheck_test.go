@@ -0,0 +1,45 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSpellCheck(t *testing.T) {
+	dictionary := Dictionary{
+		"the":   "definite article",
+		"quick": "fast",
+		"brown": "a colour",
+		"fox":   "a wild dog",
+		"box":   "a container",
+		"jumps": "leaps",
+	}
+
+	t.Run("finds a misspelled word with suggestions", func(t *testing.T) {
+		got := SpellCheck(dictionary, "The quick brown fx jumps!")
+		want := []Misspelling{
+			{Word: "fx", Pos: 16, Suggestions: []string{"fox", "box"}},
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %+v want %+v", got, want)
+		}
+	})
+
+	t.Run("strips punctuation and keeps positions", func(t *testing.T) {
+		got := SpellCheck(dictionary, `"Quick," said the fox.`)
+		want := []Misspelling{
+			{Word: "said", Pos: 9},
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %+v want %+v", got, want)
+		}
+	})
+
+	t.Run("correct text", func(t *testing.T) {
+		if got := SpellCheck(dictionary, "the quick brown fox jumps"); len(got) != 0 {
+			t.Errorf("expected no misspellings but got %+v", got)
+		}
+	})
+}
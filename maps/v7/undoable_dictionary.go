@@ -0,0 +1,95 @@
+package main
+
+// ErrNothingToUndo means Undo was called with no change left to revert
+const ErrNothingToUndo = DictionaryErr("there is nothing to undo")
+
+// undo remembers what a word was before the last change to it
+type undo struct {
+	word       string
+	definition string
+	existed    bool
+}
+
+// UndoableDictionary is a Dictionary which can revert the most recent Add,
+// AddAll, Update or Delete
+type UndoableDictionary struct {
+	Dictionary
+	// last is every word the most recent change touched, in the order they were changed
+	last []undo
+}
+
+// NewUndoableDictionary creates an UndoableDictionary holding the words in dict
+func NewUndoableDictionary(dict Dictionary) *UndoableDictionary {
+	return &UndoableDictionary{Dictionary: dict}
+}
+
+// Add inserts a word and definition into the dictionary
+func (u *UndoableDictionary) Add(word, definition string) error {
+	before := u.remember(word)
+	if err := u.Dictionary.Add(word, definition); err != nil {
+		return err
+	}
+
+	u.last = []undo{before}
+	return nil
+}
+
+// AddAll adds each of the entries in order like Dictionary.AddAll. The entries
+// which were added are remembered as one change, so Undo removes all of them
+func (u *UndoableDictionary) AddAll(entries []Entry) []error {
+	var batch []undo
+	errs := make([]error, len(entries))
+
+	for i, entry := range entries {
+		before := u.remember(entry.Word)
+		if errs[i] = u.Dictionary.Add(entry.Word, entry.Definition); errs[i] == nil {
+			batch = append(batch, before)
+		}
+	}
+
+	if len(batch) > 0 {
+		u.last = batch
+	}
+	return errs
+}
+
+// Update changes the definition of a given word
+func (u *UndoableDictionary) Update(word, definition string) error {
+	before := u.remember(word)
+	if err := u.Dictionary.Update(word, definition); err != nil {
+		return err
+	}
+
+	u.last = []undo{before}
+	return nil
+}
+
+// Delete removes a word from the dictionary
+func (u *UndoableDictionary) Delete(word string) {
+	u.last = []undo{u.remember(word)}
+	u.Dictionary.Delete(word)
+}
+
+// Undo reverts the most recent successful Add, AddAll, Update or Delete. Only
+// one change is remembered, so calling Undo again returns ErrNothingToUndo
+func (u *UndoableDictionary) Undo() error {
+	if u.last == nil {
+		return ErrNothingToUndo
+	}
+
+	for i := len(u.last) - 1; i >= 0; i-- {
+		if before := u.last[i]; before.existed {
+			u.Dictionary[before.word] = before.definition
+		} else {
+			u.Dictionary.Delete(before.word)
+		}
+	}
+
+	u.last = nil
+	return nil
+}
+
+func (u *UndoableDictionary) remember(word string) undo {
+	definition, err := u.Dictionary.Search(word)
+	return undo{word, definition, err == nil}
+}
@@ -0,0 +1,72 @@
+package main
+
+import "testing"
+
+func TestUndoableDictionary(t *testing.T) {
+	t.Run("undo an add", func(t *testing.T) {
+		dictionary := NewUndoableDictionary(Dictionary{})
+		dictionary.Add("test", "this is just a test")
+
+		assertError(t, dictionary.Undo(), nil)
+
+		_, err := dictionary.Search("test")
+		assertError(t, err, ErrNotFound)
+	})
+
+	t.Run("undo an update", func(t *testing.T) {
+		dictionary := NewUndoableDictionary(Dictionary{"test": "this is just a test"})
+		dictionary.Update("test", "new definition")
+
+		assertError(t, dictionary.Undo(), nil)
+		assertDefinition(t, dictionary, "test", "this is just a test")
+	})
+
+	t.Run("undo a delete", func(t *testing.T) {
+		dictionary := NewUndoableDictionary(Dictionary{"test": "this is just a test"})
+		dictionary.Delete("test")
+
+		assertError(t, dictionary.Undo(), nil)
+		assertDefinition(t, dictionary, "test", "this is just a test")
+	})
+
+	t.Run("only the most recent change is undone", func(t *testing.T) {
+		dictionary := NewUndoableDictionary(Dictionary{})
+		dictionary.Add("test", "this is just a test")
+		dictionary.Update("test", "new definition")
+
+		assertError(t, dictionary.Undo(), nil)
+		assertDefinition(t, dictionary, "test", "this is just a test")
+
+		assertError(t, dictionary.Undo(), ErrNothingToUndo)
+		assertDefinition(t, dictionary, "test", "this is just a test")
+	})
+
+	t.Run("failed changes are not undoable", func(t *testing.T) {
+		dictionary := NewUndoableDictionary(Dictionary{"test": "this is just a test"})
+		dictionary.Add("test", "another test")
+
+		assertError(t, dictionary.Undo(), ErrNothingToUndo)
+		assertDefinition(t, dictionary, "test", "this is just a test")
+	})
+
+	t.Run("undo a bulk add", func(t *testing.T) {
+		dictionary := NewUndoableDictionary(Dictionary{})
+		dictionary.Add("test", "this is just a test")
+		dictionary.AddAll([]Entry{{"cat", "a pet"}, {"dog", "another pet"}, {"test", "a clash"}})
+
+		assertError(t, dictionary.Undo(), nil)
+
+		// the whole batch is undone, but the add before it is kept
+		assertDefinition(t, dictionary, "test", "this is just a test")
+		for _, word := range []string{"cat", "dog"} {
+			_, err := dictionary.Search(word)
+			assertError(t, err, ErrNotFound)
+		}
+
+		assertError(t, dictionary.Undo(), ErrNothingToUndo)
+	})
+
+	t.Run("nothing to undo", func(t *testing.T) {
+		assertError(t, NewUndoableDictionary(Dictionary{}).Undo(), ErrNothingToUndo)
+	})
+}
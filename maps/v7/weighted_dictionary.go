@@ -0,0 +1,41 @@
+package main
+
+// WeightedDictionary is a Dictionary where words can be given a weight, such
+// as how frequently they are used, so fuzzy searches suggest common words first
+type WeightedDictionary struct {
+	Dictionary
+	weights map[string]int
+}
+
+// NewWeightedDictionary creates a WeightedDictionary holding the words in dict, all with no weight
+func NewWeightedDictionary(dict Dictionary) *WeightedDictionary {
+	return &WeightedDictionary{
+		Dictionary: dict,
+		weights:    map[string]int{},
+	}
+}
+
+// AddWeighted inserts a word, its definition and weight into the dictionary
+func (w *WeightedDictionary) AddWeighted(word, definition string, weight int) error {
+	if err := w.Add(word, definition); err != nil {
+		return err
+	}
+
+	w.weights[word] = weight
+	return nil
+}
+
+// Delete removes a word and its weight from the dictionary
+func (w *WeightedDictionary) Delete(word string) {
+	w.Dictionary.Delete(word)
+	delete(w.weights, word)
+}
+
+// FuzzySearch returns the words within maxDistance edits of word, closest
+// first. Words as close as each other are ranked by weight, heaviest first,
+// and then alphabetically
+func (w *WeightedDictionary) FuzzySearch(word string, maxDistance int) []string {
+	return fuzzySearch(w.Dictionary, word, maxDistance, func(candidate string) int {
+		return w.weights[candidate]
+	})
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWeightedDictionary(t *testing.T) {
+	newDictionary := func() *WeightedDictionary {
+		dictionary := NewWeightedDictionary(Dictionary{"tent": "a shelter"})
+		dictionary.AddWeighted("test", "this is just a test", 5)
+		dictionary.AddWeighted("best", "better than the rest", 50)
+		dictionary.AddWeighted("rest", "a break", 1)
+		return dictionary
+	}
+
+	t.Run("more frequent words outrank equally close ones", func(t *testing.T) {
+		got := newDictionary().FuzzySearch("xest", 1)
+		want := []string{"best", "test", "rest"}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %q want %q", got, want)
+		}
+	})
+
+	t.Run("closer words still come first", func(t *testing.T) {
+		got := newDictionary().FuzzySearch("test", 1)
+		want := []string{"test", "best", "rest", "tent"}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %q want %q", got, want)
+		}
+	})
+
+	t.Run("adding an existing word fails", func(t *testing.T) {
+		err := newDictionary().AddWeighted("test", "another test", 100)
+		assertError(t, err, ErrWordExists)
+	})
+}
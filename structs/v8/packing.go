@@ -0,0 +1,48 @@
+package main
+
+import "math"
+
+// BoundingBox returns the smallest upright rectangle the shape fits inside,
+// or false if the shape is not a flat shape we know the dimensions of
+func BoundingBox(shape Shape) (Rectangle, bool) {
+	switch s := shape.(type) {
+	case Rectangle:
+		return s, true
+	case Circle:
+		return Rectangle{Width: 2 * s.Radius, Height: 2 * s.Radius}, true
+	case Triangle:
+		return Rectangle{Width: s.Base, Height: s.Height}, true
+	case Polygon:
+		if len(s.Vertices) == 0 {
+			return Rectangle{}, true
+		}
+
+		min, max := s.Vertices[0], s.Vertices[0]
+		for _, v := range s.Vertices[1:] {
+			min.X, min.Y = math.Min(min.X, v.X), math.Min(min.Y, v.Y)
+			max.X, max.Y = math.Max(max.X, v.X), math.Max(max.Y, v.Y)
+		}
+		return Rectangle{Width: max.X - min.X, Height: max.Y - min.Y}, true
+	}
+
+	return Rectangle{}, false
+}
+
+// MaxShapesInContainer estimates how many copies of shape can be cut from
+// container by laying its bounding box out in a grid, without rotating it.
+// Shapes with no bounding box, or one with no width or height, return 0
+func MaxShapesInContainer(shape Shape, container Rectangle) int {
+	box, ok := BoundingBox(shape)
+	if !ok || box.Width <= 0 || box.Height <= 0 {
+		return 0
+	}
+
+	columns := math.Floor(container.Width / box.Width)
+	rows := math.Floor(container.Height / box.Height)
+
+	if columns <= 0 || rows <= 0 {
+		return 0
+	}
+
+	return int(columns * rows)
+}
@@ -0,0 +1,31 @@
+package main
+
+import "testing"
+
+func TestMaxShapesInContainer(t *testing.T) {
+	container := Rectangle{Width: 10, Height: 7}
+
+	packingTests := []struct {
+		name  string
+		shape Shape
+		want  int
+	}{
+		{name: "squares which divide exactly", shape: Rectangle{Width: 1, Height: 1}, want: 70},
+		{name: "squares with space left over", shape: Rectangle{Width: 3, Height: 3}, want: 6},
+		{name: "a square too big for the container", shape: Rectangle{Width: 8, Height: 8}, want: 0},
+		{name: "circles use their bounding square", shape: Circle{Radius: 1}, want: 15},
+		{name: "triangles use their base and height", shape: Triangle{Base: 5, Height: 7}, want: 2},
+		{name: "polygons use their vertices", shape: Polygon{[]Point{{1, 1}, {3, 1}, {2, 3}}}, want: 15},
+		{name: "shapes with no size", shape: Rectangle{Width: 0, Height: 1}, want: 0},
+		{name: "solids cannot be packed", shape: Cube{Side: 1}, want: 0},
+	}
+
+	for _, tt := range packingTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MaxShapesInContainer(tt.shape, container)
+			if got != tt.want {
+				t.Errorf("%#v got %d want %d", tt.shape, got, tt.want)
+			}
+		})
+	}
+}
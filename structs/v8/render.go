@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ErrInvalidWidth means a shape was asked to be rendered less than one character wide
+var ErrInvalidWidth = errors.New("cannot render a shape less than one character wide")
+
+// ErrInvalidShape means a shape was asked to be rendered with a dimension that is not a positive, finite number
+var ErrInvalidShape = errors.New("cannot render a shape whose dimensions are not positive and finite")
+
+// maxRenderRows is the most rows RenderASCII draws, so tall, narrow shapes do not make huge strings
+const maxRenderRows = 100
+
+// UnrenderableShapeErr means RenderASCII does not know how to draw the shape
+type UnrenderableShapeErr struct {
+	Shape Shape
+}
+
+func (e UnrenderableShapeErr) Error() string {
+	return fmt.Sprintf("cannot render %#v", e.Shape)
+}
+
+// region is a shape which knows which points are inside it
+type region interface {
+	Contains(p Point) bool
+}
+
+// RenderASCII draws a rectangle or circle width characters wide, with a # for
+// each character whose centre the shape Contains and a . for the rest.
+// Characters are roughly twice as tall as they are wide, so half as many rows
+// are drawn to keep the shape's proportions, up to maxRenderRows after which
+// taller shapes are squashed to fit
+func RenderASCII(shape Shape, width int) (string, error) {
+	if width < 1 {
+		return "", ErrInvalidWidth
+	}
+
+	var r region
+	var bottomLeft Point
+	var dimensions []float64
+	switch s := shape.(type) {
+	case Rectangle:
+		r, dimensions = s, []float64{s.Width, s.Height}
+	case Circle:
+		r, bottomLeft, dimensions = s, Point{-s.Radius, -s.Radius}, []float64{s.Radius}
+	default:
+		return "", UnrenderableShapeErr{shape}
+	}
+
+	for _, d := range dimensions {
+		if !(d > 0) || math.IsInf(d, 0) {
+			return "", ErrInvalidShape
+		}
+	}
+
+	box, _ := BoundingBox(shape)
+	cellWidth := box.Width / float64(width)
+	rows := int(math.Min(maxRenderRows, math.Max(1, math.Round(box.Height/(2*cellWidth)))))
+	cellHeight := box.Height / float64(rows)
+
+	var out strings.Builder
+	for row := rows - 1; row >= 0; row-- {
+		for column := 0; column < width; column++ {
+			centre := Point{
+				X: bottomLeft.X + (float64(column)+0.5)*cellWidth,
+				Y: bottomLeft.Y + (float64(row)+0.5)*cellHeight,
+			}
+
+			if r.Contains(centre) {
+				out.WriteByte('#')
+			} else {
+				out.WriteByte('.')
+			}
+		}
+		out.WriteByte('\n')
+	}
+
+	return out.String(), nil
+}
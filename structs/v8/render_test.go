@@ -0,0 +1,119 @@
+package main
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestRenderASCII(t *testing.T) {
+	renderTests := []struct {
+		name  string
+		shape Shape
+		width int
+		want  string
+	}{
+		{
+			name:  "a rectangle fills every row",
+			shape: Rectangle{Width: 4, Height: 4},
+			width: 4,
+			want:  "####\n####\n",
+		},
+		{
+			name:  "a circle leaves its corners empty",
+			shape: Circle{Radius: 2},
+			width: 8,
+			want: ".######.\n" +
+				"########\n" +
+				"########\n" +
+				".######.\n",
+		},
+	}
+
+	for _, tt := range renderTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderASCII(tt.shape, tt.width)
+			if err != nil {
+				t.Fatalf("didn't expect an error, %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("unsupported shapes are an error", func(t *testing.T) {
+		triangle := Triangle{Base: 12, Height: 6}
+
+		_, err := RenderASCII(triangle, 10)
+		if err != (UnrenderableShapeErr{triangle}) {
+			t.Errorf("got error %v want %v", err, UnrenderableShapeErr{triangle})
+		}
+	})
+
+	t.Run("the width must be at least one", func(t *testing.T) {
+		_, err := RenderASCII(Circle{Radius: 1}, 0)
+		if err != ErrInvalidWidth {
+			t.Errorf("got error %v want %v", err, ErrInvalidWidth)
+		}
+	})
+
+	t.Run("tall shapes are squashed to the most rows", func(t *testing.T) {
+		for _, rectangle := range []Rectangle{{Width: 1, Height: 1000}, {Width: 1e-300, Height: 1e300}} {
+			got, err := RenderASCII(rectangle, 2)
+			if err != nil {
+				t.Fatalf("did not expect an error rendering %v but got %v", rectangle, err)
+			}
+
+			if want := strings.Repeat("##\n", maxRenderRows); got != want {
+				t.Errorf("got %d rows for %v want %d", strings.Count(got, "\n"), rectangle, maxRenderRows)
+			}
+		}
+	})
+
+	invalidShapes := []struct {
+		name  string
+		shape Shape
+	}{
+		{name: "zero width rectangle", shape: Rectangle{Width: 0, Height: 5}},
+		{name: "zero height rectangle", shape: Rectangle{Width: 5, Height: 0}},
+		{name: "negative rectangle", shape: Rectangle{Width: -5, Height: 5}},
+		{name: "infinite rectangle", shape: Rectangle{Width: math.Inf(1), Height: 5}},
+		{name: "NaN rectangle", shape: Rectangle{Width: math.NaN(), Height: 5}},
+		{name: "zero radius circle", shape: Circle{Radius: 0}},
+		{name: "infinite circle", shape: Circle{Radius: math.Inf(1)}},
+	}
+
+	for _, tt := range invalidShapes {
+		t.Run("the shape's dimensions must be positive and finite, "+tt.name, func(t *testing.T) {
+			_, err := RenderASCII(tt.shape, 10)
+			if err != ErrInvalidShape {
+				t.Errorf("got error %v want %v", err, ErrInvalidShape)
+			}
+		})
+	}
+}
+
+func TestContains(t *testing.T) {
+	containsTests := []struct {
+		name   string
+		shape  region
+		point  Point
+		inside bool
+	}{
+		{name: "inside a rectangle", shape: Rectangle{4, 2}, point: Point{1, 1}, inside: true},
+		{name: "on a rectangle's edge", shape: Rectangle{4, 2}, point: Point{4, 2}, inside: true},
+		{name: "outside a rectangle", shape: Rectangle{4, 2}, point: Point{1, 3}, inside: false},
+		{name: "inside a circle", shape: Circle{2}, point: Point{-1, 1}, inside: true},
+		{name: "outside a circle", shape: Circle{2}, point: Point{1.5, 1.5}, inside: false},
+	}
+
+	for _, tt := range containsTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.shape.Contains(tt.point); got != tt.inside {
+				t.Errorf("%#v.Contains(%v) got %t want %t", tt.shape, tt.point, got, tt.inside)
+			}
+		})
+	}
+}
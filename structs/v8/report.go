@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"text/tabwriter"
+)
+
+// WriteAreaReport writes a table of each shape's type, area and perimeter to w,
+// followed by a row of totals. Shapes without a perimeter show "-" and are left out of its total
+func WriteAreaReport(w io.Writer, shapes []Shape) error {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(table, "Type\tArea\tPerimeter")
+
+	totalArea, totalPerimeter := 0.0, 0.0
+	for _, shape := range shapes {
+		area := shape.Area()
+		totalArea += area
+
+		perimeter := "-"
+		if p, ok := shape.(interface{ Perimeter() float64 }); ok {
+			perimeter = fmt.Sprintf("%.2f", p.Perimeter())
+			totalPerimeter += p.Perimeter()
+		}
+
+		fmt.Fprintf(table, "%s\t%.2f\t%s\n", shapeName(shape), area, perimeter)
+	}
+
+	fmt.Fprintf(table, "Total\t%.2f\t%.2f\n", totalArea, totalPerimeter)
+
+	return table.Flush()
+}
+
+// shapeName is the name of the shape's type, looking through pointers so a
+// *Rectangle is named Rectangle
+func shapeName(shape Shape) string {
+	t := reflect.TypeOf(shape)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteAreaReport(t *testing.T) {
+	shapes := []Shape{
+		Rectangle{Width: 12, Height: 6},
+		Circle{Radius: 10},
+		Triangle{Base: 12, Height: 6},
+	}
+
+	buffer := bytes.Buffer{}
+	err := WriteAreaReport(&buffer, shapes)
+
+	if err != nil {
+		t.Fatalf("did not expect an error but got %v", err)
+	}
+
+	got := buffer.String()
+	want := `Type       Area    Perimeter
+Rectangle  72.00   36.00
+Circle     314.16  62.83
+Triangle   36.00   -
+Total      422.16  98.83
+`
+
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestWriteAreaReportPointerShapes(t *testing.T) {
+	buffer := bytes.Buffer{}
+	err := WriteAreaReport(&buffer, []Shape{&Rectangle{Width: 12, Height: 6}})
+
+	if err != nil {
+		t.Fatalf("did not expect an error but got %v", err)
+	}
+
+	got := buffer.String()
+	want := `Type       Area   Perimeter
+Rectangle  72.00  36.00
+Total      72.00  36.00
+`
+
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrMissingShapeType means shape json did not say what type of shape it is
+var ErrMissingShapeType = errors.New(`shape json must have a "type"`)
+
+// UnknownShapeTypeErr means shape json was for a type of shape we do not know about
+type UnknownShapeTypeErr struct {
+	Type string
+}
+
+func (e UnknownShapeTypeErr) Error() string {
+	return fmt.Sprintf("unknown shape type %q", e.Type)
+}
+
+// InvalidShapeFieldErr describes a required field of shape json which is missing or not positive
+type InvalidShapeFieldErr struct {
+	Type   string
+	Field  string
+	Reason string
+}
+
+func (e InvalidShapeFieldErr) Error() string {
+	return fmt.Sprintf("%s field %q %s", e.Type, e.Field, e.Reason)
+}
+
+// shapeFields lists the numeric fields each type of shape needs
+var shapeFields = map[string][]string{
+	"rectangle": {"width", "height"},
+	"circle":    {"radius"},
+	"triangle":  {"base", "height"},
+	"cube":      {"side"},
+	"sphere":    {"radius"},
+}
+
+// ValidateShapeJSON checks shape json such as {"type": "circle", "radius": 10}
+// has a known type and that the fields that type needs are positive numbers
+func ValidateShapeJSON(data []byte) error {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("problem parsing shape json, %v", err)
+	}
+
+	shapeType, ok := fields["type"].(string)
+	if !ok || shapeType == "" {
+		return ErrMissingShapeType
+	}
+
+	required, known := shapeFields[shapeType]
+	if !known {
+		return UnknownShapeTypeErr{shapeType}
+	}
+
+	for _, name := range required {
+		value, present := fields[name]
+		if !present {
+			return InvalidShapeFieldErr{shapeType, name, "is missing"}
+		}
+
+		number, isNumber := value.(float64)
+		if !isNumber {
+			return InvalidShapeFieldErr{shapeType, name, "must be a number"}
+		}
+
+		if number <= 0 {
+			return InvalidShapeFieldErr{shapeType, name, "must be positive"}
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalShape validates shape json with ValidateShapeJSON and then decodes it into the shape it describes
+func UnmarshalShape(data []byte) (Shape, error) {
+	if err := ValidateShapeJSON(data); err != nil {
+		return nil, err
+	}
+
+	var header struct {
+		Type string `json:"type"`
+	}
+	json.Unmarshal(data, &header)
+
+	switch header.Type {
+	case "rectangle":
+		var r Rectangle
+		return r, json.Unmarshal(data, &r)
+	case "circle":
+		var c Circle
+		return c, json.Unmarshal(data, &c)
+	case "triangle":
+		var t Triangle
+		return t, json.Unmarshal(data, &t)
+	case "cube":
+		var c Cube
+		return c, json.Unmarshal(data, &c)
+	default:
+		var s Sphere
+		return s, json.Unmarshal(data, &s)
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateShapeJSON(t *testing.T) {
+	validateTests := []struct {
+		name string
+		json string
+		want error
+	}{
+		{name: "valid rectangle", json: `{"type": "rectangle", "width": 12, "height": 6}`, want: nil},
+		{name: "valid circle", json: `{"type": "circle", "radius": 10}`, want: nil},
+		{name: "missing type", json: `{"radius": 10}`, want: ErrMissingShapeType},
+		{name: "unknown type", json: `{"type": "hexagon", "side": 1}`, want: UnknownShapeTypeErr{"hexagon"}},
+		{name: "missing field", json: `{"type": "rectangle", "width": 12}`, want: InvalidShapeFieldErr{"rectangle", "height", "is missing"}},
+		{name: "negative field", json: `{"type": "circle", "radius": -1}`, want: InvalidShapeFieldErr{"circle", "radius", "must be positive"}},
+		{name: "non numeric field", json: `{"type": "cube", "side": "big"}`, want: InvalidShapeFieldErr{"cube", "side", "must be a number"}},
+	}
+
+	for _, tt := range validateTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateShapeJSON([]byte(tt.json))
+			if got != tt.want {
+				t.Errorf("got %v want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("invalid json", func(t *testing.T) {
+		if err := ValidateShapeJSON([]byte(`{"type":`)); err == nil {
+			t.Error("expected an error for invalid json")
+		}
+	})
+}
+
+func TestUnmarshalShape(t *testing.T) {
+	t.Run("decodes a valid shape", func(t *testing.T) {
+		got, err := UnmarshalShape([]byte(`{"type": "triangle", "base": 12, "height": 6}`))
+		if err != nil {
+			t.Fatalf("didn't expect an error, %v", err)
+		}
+
+		want := Triangle{Base: 12, Height: 6}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("got %#v want %#v", got, want)
+		}
+	})
+
+	t.Run("rejects invalid shapes", func(t *testing.T) {
+		_, err := UnmarshalShape([]byte(`{"type": "sphere", "radius": 0}`))
+
+		want := InvalidShapeFieldErr{"sphere", "radius", "must be positive"}
+		if err != want {
+			t.Errorf("got %v want %v", err, want)
+		}
+	})
+}
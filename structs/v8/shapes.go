@@ -1,18 +1,115 @@
 package main
 
-import "math"
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
 
 // Shape is implemented by anything that can tell us its Area
 type Shape interface {
 	Area() float64
 }
 
+// EqualShapes reports whether a and b are the same type of shape with the same values
+func EqualShapes(a, b Shape) bool {
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// TotalArea adds up the areas of all the shapes
+func TotalArea(shapes []Shape) float64 {
+	total := 0.0
+	for _, shape := range shapes {
+		total += shape.Area()
+	}
+	return total
+}
+
+// ParallelTotalArea adds up the areas of all the shapes, splitting them between
+// workers which each sum their share concurrently
+func ParallelTotalArea(shapes []Shape, workers int) float64 {
+	if workers < 1 {
+		workers = 1
+	}
+
+	chunkSize := (len(shapes) + workers - 1) / workers
+	partials := make(chan float64, workers)
+	started := 0
+
+	for start := 0; start < len(shapes); start += chunkSize {
+		end := start + chunkSize
+		if end > len(shapes) {
+			end = len(shapes)
+		}
+
+		started++
+		go func(chunk []Shape) {
+			partials <- TotalArea(chunk)
+		}(shapes[start:end])
+	}
+
+	total := 0.0
+	for i := 0; i < started; i++ {
+		total += <-partials
+	}
+	return total
+}
+
+// InvalidShapeErr describes a shape in a batch whose area is zero, negative or NaN
+type InvalidShapeErr struct {
+	Index int
+	Shape Shape
+}
+
+func (e InvalidShapeErr) Error() string {
+	return fmt.Sprintf("shape %d %#v has invalid area %v", e.Index, e.Shape, e.Shape.Area())
+}
+
+// ValidateShapes returns an InvalidShapeErr for every shape which does not have a positive area
+func ValidateShapes(shapes []Shape) []error {
+	errs := []error{}
+	for i, shape := range shapes {
+		area := shape.Area()
+		if math.IsNaN(area) || area <= 0 {
+			errs = append(errs, InvalidShapeErr{i, shape})
+		}
+	}
+	return errs
+}
+
+// Solid is a Shape with depth, its Area is the total area of its surface
+type Solid interface {
+	Shape
+	Volume() float64
+}
+
+// ErrZeroVolume means a ratio to volume was asked for of a solid which has no volume
+var ErrZeroVolume = errors.New("cannot divide by the volume of a solid with no volume")
+
+// SurfaceToVolumeRatio returns how much surface area the solid has per unit of volume
+func SurfaceToVolumeRatio(s Solid) (float64, error) {
+	volume := s.Volume()
+	if volume == 0 {
+		return 0, ErrZeroVolume
+	}
+	return s.Area() / volume, nil
+}
+
 // Rectangle has the dimensions of a rectangle
 type Rectangle struct {
 	Width  float64
 	Height float64
 }
 
+// Contains reports whether p is inside or on the edge of the rectangle, whose bottom left corner is the origin
+func (r Rectangle) Contains(p Point) bool {
+	return p.X >= 0 && p.X <= r.Width && p.Y >= 0 && p.Y <= r.Height
+}
+
 // Area returns the area of the rectangle
 func (r Rectangle) Area() float64 {
 	return r.Width * r.Height
@@ -20,7 +117,30 @@ func (r Rectangle) Area() float64 {
 
 // Perimeter returns the perimeter of a rectangle
 func Perimeter(rectangle Rectangle) float64 {
-	return 2 * (rectangle.Width + rectangle.Height)
+	return rectangle.Perimeter()
+}
+
+// Perimeter returns the perimeter of the rectangle
+func (r Rectangle) Perimeter() float64 {
+	return 2 * (r.Width + r.Height)
+}
+
+// PositionedRectangle is a Rectangle placed on a plane with its bottom left corner at Origin
+type PositionedRectangle struct {
+	Rectangle
+	Origin Point
+}
+
+// IntersectionArea returns the area where two axis aligned rectangles overlap, 0 if they do not
+func IntersectionArea(a, b PositionedRectangle) float64 {
+	width := math.Min(a.Origin.X+a.Width, b.Origin.X+b.Width) - math.Max(a.Origin.X, b.Origin.X)
+	height := math.Min(a.Origin.Y+a.Height, b.Origin.Y+b.Height) - math.Max(a.Origin.Y, b.Origin.Y)
+
+	if width <= 0 || height <= 0 {
+		return 0
+	}
+
+	return width * height
 }
 
 // Circle represents a circle...
@@ -28,11 +148,38 @@ type Circle struct {
 	Radius float64
 }
 
+// Contains reports whether p is inside or on the edge of the circle, centred on the origin
+func (c Circle) Contains(p Point) bool {
+	return p.X*p.X+p.Y*p.Y <= c.Radius*c.Radius
+}
+
 // Area returns the area of the circle
 func (c Circle) Area() float64 {
 	return math.Pi * c.Radius * c.Radius
 }
 
+// Perimeter returns the circumference of the circle
+func (c Circle) Perimeter() float64 {
+	return 2 * math.Pi * c.Radius
+}
+
+// Approximate returns a regular polygon with the given number of sides
+// inscribed in the circle. The more sides, the closer its area is to the circle's.
+// Fewer than three sides cannot enclose an area so an empty Polygon is returned
+func (c Circle) Approximate(sides int) Polygon {
+	if sides < 3 {
+		return Polygon{}
+	}
+
+	vertices := make([]Point, sides)
+	for i := range vertices {
+		angle := 2 * math.Pi * float64(i) / float64(sides)
+		vertices[i] = Point{c.Radius * math.Cos(angle), c.Radius * math.Sin(angle)}
+	}
+
+	return Polygon{vertices}
+}
+
 // Triangle represents the dimensions of a triangle
 type Triangle struct {
 	Base   float64
@@ -43,3 +190,64 @@ type Triangle struct {
 func (c Triangle) Area() float64 {
 	return (c.Base * c.Height) * 0.5
 }
+
+// Point is a position on a plane
+type Point struct {
+	X float64
+	Y float64
+}
+
+// Polygon represents a closed shape made of straight sides between its vertices
+type Polygon struct {
+	Vertices []Point
+}
+
+// Area returns the area of the polygon using the shoelace formula
+func (p Polygon) Area() float64 {
+	sum := 0.0
+	for i, v := range p.Vertices {
+		next := p.Vertices[(i+1)%len(p.Vertices)]
+		sum += v.X*next.Y - next.X*v.Y
+	}
+	return math.Abs(sum) / 2
+}
+
+// Perimeter returns the total length of the polygon's sides
+func (p Polygon) Perimeter() float64 {
+	sum := 0.0
+	for i, v := range p.Vertices {
+		next := p.Vertices[(i+1)%len(p.Vertices)]
+		sum += math.Hypot(next.X-v.X, next.Y-v.Y)
+	}
+	return sum
+}
+
+// Cube represents a cube with sides of equal length
+type Cube struct {
+	Side float64
+}
+
+// Area returns the surface area of the cube
+func (c Cube) Area() float64 {
+	return 6 * c.Side * c.Side
+}
+
+// Volume returns the volume of the cube
+func (c Cube) Volume() float64 {
+	return c.Side * c.Side * c.Side
+}
+
+// Sphere represents a sphere
+type Sphere struct {
+	Radius float64
+}
+
+// Area returns the surface area of the sphere
+func (s Sphere) Area() float64 {
+	return 4 * math.Pi * s.Radius * s.Radius
+}
+
+// Volume returns the volume of the sphere
+func (s Sphere) Volume() float64 {
+	return 4.0 / 3.0 * math.Pi * s.Radius * s.Radius * s.Radius
+}
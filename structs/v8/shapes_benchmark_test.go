@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+)
+
+func BenchmarkTotalArea(b *testing.B) {
+	shapes := manyShapes(1000000)
+
+	b.Run("sequential", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			TotalArea(shapes)
+		}
+	})
+
+	workers := runtime.GOMAXPROCS(0)
+	b.Run(fmt.Sprintf("parallel with %d workers", workers), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ParallelTotalArea(shapes, workers)
+		}
+	})
+}
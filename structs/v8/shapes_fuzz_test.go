@@ -0,0 +1,82 @@
+package main
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func FuzzRectangleArea(f *testing.F) {
+	f.Add(0.0, 0.0)
+	f.Add(12.0, 6.0)
+	f.Add(1e-300, 1e-300)
+	f.Add(math.SmallestNonzeroFloat64, math.MaxFloat64)
+	f.Add(math.MaxFloat64, math.MaxFloat64)
+	f.Add(-1.0, 5.0)
+
+	f.Fuzz(func(t *testing.T, width, height float64) {
+		if !isFiniteNonNegative(width) || !isFiniteNonNegative(height) {
+			t.Skip()
+		}
+
+		area := Rectangle{Width: width, Height: height}.Area()
+
+		if area != width*height {
+			t.Errorf("Rectangle{%g, %g} got area %g want %g", width, height, area, width*height)
+		}
+
+		if math.IsNaN(area) || area < 0 {
+			t.Errorf("Rectangle{%g, %g} got invalid area %g", width, height, area)
+		}
+
+		if math.IsInf(area, 0) && !overflows(width, height) {
+			t.Errorf("Rectangle{%g, %g} overflowed to %g", width, height, area)
+		}
+	})
+}
+
+func FuzzCircleArea(f *testing.F) {
+	f.Add(0.0)
+	f.Add(10.0)
+	f.Add(math.SmallestNonzeroFloat64)
+	f.Add(math.MaxFloat64)
+	f.Add(-1.0)
+
+	f.Fuzz(func(t *testing.T, radius float64) {
+		if !isFiniteNonNegative(radius) {
+			t.Skip()
+		}
+
+		area := Circle{Radius: radius}.Area()
+
+		if want := math.Pi * radius * radius; area != want {
+			t.Errorf("Circle{%g} got area %g want %g", radius, area, want)
+		}
+
+		if math.IsNaN(area) || area < 0 {
+			t.Errorf("Circle{%g} got invalid area %g", radius, area)
+		}
+
+		if math.IsInf(area, 0) && !overflows(math.Pi, radius, radius) {
+			t.Errorf("Circle{%g} overflowed to %g", radius, area)
+		}
+	})
+}
+
+// overflows reports whether the exact product of factors is too big for a
+// float64. Areas whose true value is that big are allowed to become +Inf, as
+// that is how float64 multiplication overflows, but any other infinite area is
+// a bug. Products within rounding of math.MaxFloat64 count as too big
+func overflows(factors ...float64) bool {
+	product := new(big.Float).SetPrec(uint(53 * len(factors))).SetFloat64(1)
+	for _, factor := range factors {
+		product.Mul(product, big.NewFloat(factor))
+	}
+
+	limit := big.NewFloat(math.MaxFloat64 * (1 - 1e-15))
+	return product.Cmp(limit) > 0
+}
+
+func isFiniteNonNegative(f float64) bool {
+	return f >= 0 && !math.IsInf(f, 1)
+}
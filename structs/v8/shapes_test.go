@@ -1,6 +1,7 @@
 package main
 
 import (
+	"math"
 	"testing"
 )
 
@@ -37,3 +38,181 @@ func TestArea(t *testing.T) {
 	}
 
 }
+
+func TestIntersectionArea(t *testing.T) {
+	square := PositionedRectangle{Rectangle{4, 4}, Point{0, 0}}
+
+	intersectionTests := []struct {
+		name    string
+		other   PositionedRectangle
+		hasArea float64
+	}{
+		{name: "overlapping", other: PositionedRectangle{Rectangle{4, 4}, Point{2, 1}}, hasArea: 6},
+		{name: "contained", other: PositionedRectangle{Rectangle{1, 2}, Point{1, 1}}, hasArea: 2},
+		{name: "touching edges", other: PositionedRectangle{Rectangle{4, 4}, Point{4, 0}}, hasArea: 0},
+		{name: "touching corners", other: PositionedRectangle{Rectangle{4, 4}, Point{4, 4}}, hasArea: 0},
+		{name: "disjoint", other: PositionedRectangle{Rectangle{2, 2}, Point{-5, 10}}, hasArea: 0},
+	}
+
+	for _, tt := range intersectionTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IntersectionArea(square, tt.other)
+			if got != tt.hasArea {
+				t.Errorf("%#v got %.2f want %.2f", tt.other, got, tt.hasArea)
+			}
+
+			if reversed := IntersectionArea(tt.other, square); reversed != got {
+				t.Errorf("got %.2f with the rectangles swapped, want %.2f", reversed, got)
+			}
+		})
+	}
+}
+
+func TestParallelTotalArea(t *testing.T) {
+	shapes := manyShapes(10001)
+	want := TotalArea(shapes)
+
+	for _, workers := range []int{0, 1, 3, 8, 20000} {
+		got := ParallelTotalArea(shapes, workers)
+
+		if math.Abs(got-want) > 1e-6*want {
+			t.Errorf("with %d workers got %.6f want %.6f", workers, got, want)
+		}
+	}
+
+	if got := ParallelTotalArea(nil, 4); got != 0 {
+		t.Errorf("got %.2f for no shapes want 0", got)
+	}
+}
+
+func manyShapes(n int) []Shape {
+	shapes := make([]Shape, n)
+	for i := range shapes {
+		switch i % 3 {
+		case 0:
+			shapes[i] = Rectangle{float64(i % 7), float64(i % 11)}
+		case 1:
+			shapes[i] = Circle{float64(i % 5)}
+		case 2:
+			shapes[i] = Triangle{float64(i % 13), float64(i % 3)}
+		}
+	}
+	return shapes
+}
+
+func TestValidateShapes(t *testing.T) {
+	t.Run("valid shapes", func(t *testing.T) {
+		shapes := []Shape{Rectangle{12, 6}, Circle{10}, Triangle{12, 6}}
+
+		got := ValidateShapes(shapes)
+
+		if len(got) != 0 {
+			t.Errorf("expected no errors but got %v", got)
+		}
+	})
+
+	t.Run("invalid shapes", func(t *testing.T) {
+		shapes := []Shape{
+			Rectangle{12, 6},
+			Rectangle{0, 6},
+			Circle{10},
+			Triangle{-12, 6},
+			Circle{math.NaN()},
+		}
+
+		got := ValidateShapes(shapes)
+
+		wantIndexes := []int{1, 3, 4}
+		if len(got) != len(wantIndexes) {
+			t.Fatalf("got %d errors want %d, %v", len(got), len(wantIndexes), got)
+		}
+
+		for i, err := range got {
+			invalid, ok := err.(InvalidShapeErr)
+			if !ok {
+				t.Fatalf("got error %#v want an InvalidShapeErr", err)
+			}
+			if invalid.Index != wantIndexes[i] {
+				t.Errorf("got invalid shape at %d want %d", invalid.Index, wantIndexes[i])
+			}
+		}
+	})
+}
+
+func TestCircleApproximate(t *testing.T) {
+	circle := Circle{Radius: 10}
+	previousError := math.Inf(1)
+
+	for _, sides := range []int{3, 6, 12, 100, 1000} {
+		polygon := circle.Approximate(sides)
+
+		if len(polygon.Vertices) != sides {
+			t.Fatalf("got %d vertices want %d", len(polygon.Vertices), sides)
+		}
+
+		difference := circle.Area() - polygon.Area()
+		if difference < 0 || difference >= previousError {
+			t.Errorf("area of %d sided polygon %.4f did not get closer to %.4f", sides, polygon.Area(), circle.Area())
+		}
+		previousError = difference
+	}
+
+	if previousError > 0.01 {
+		t.Errorf("1000 sided polygon was %.4f away from the circle's area, want within 0.01", previousError)
+	}
+}
+
+func TestSurfaceToVolumeRatio(t *testing.T) {
+
+	ratioTests := []struct {
+		name     string
+		solid    Solid
+		hasRatio float64
+	}{
+		// the ratio of a cube is 6/side and of a sphere 3/radius
+		{name: "Cube", solid: Cube{Side: 2}, hasRatio: 3.0},
+		{name: "Sphere", solid: Sphere{Radius: 6}, hasRatio: 0.5},
+	}
+
+	for _, tt := range ratioTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SurfaceToVolumeRatio(tt.solid)
+			if err != nil {
+				t.Fatalf("did not expect an error but got %v", err)
+			}
+			if math.Abs(got-tt.hasRatio) > 1e-9 {
+				t.Errorf("%#v got %.4f want %.4f", tt.solid, got, tt.hasRatio)
+			}
+		})
+	}
+
+	t.Run("zero volume", func(t *testing.T) {
+		_, err := SurfaceToVolumeRatio(Cube{Side: 0})
+		if err != ErrZeroVolume {
+			t.Errorf("got error %v want %v", err, ErrZeroVolume)
+		}
+	})
+}
+
+func TestEqualShapes(t *testing.T) {
+	equalTests := []struct {
+		name string
+		a, b Shape
+		want bool
+	}{
+		{name: "equal rectangles", a: Rectangle{3, 4}, b: Rectangle{3, 4}, want: true},
+		{name: "equal polygons", a: Polygon{[]Point{{0, 0}, {1, 0}, {0, 1}}}, b: Polygon{[]Point{{0, 0}, {1, 0}, {0, 1}}}, want: true},
+		{name: "different values", a: Rectangle{3, 4}, b: Rectangle{4, 3}, want: false},
+		{name: "different types", a: Rectangle{3, 4}, b: Circle{3}, want: false},
+		{name: "same fields but different types", a: Rectangle{3, 4}, b: Triangle{3, 4}, want: false},
+		{name: "value and pointer", a: Circle{3}, b: &Circle{3}, want: false},
+	}
+
+	for _, tt := range equalTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualShapes(tt.a, tt.b); got != tt.want {
+				t.Errorf("EqualShapes(%#v, %#v) got %t want %t", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
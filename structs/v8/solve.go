@@ -0,0 +1,48 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrNegativeArea means a shape was asked to have an area less than zero
+var ErrNegativeArea = errors.New("cannot solve for a negative area")
+
+// ErrCannotScale means a shape with no area cannot be scaled to have one
+var ErrCannotScale = errors.New("cannot scale a shape with no area to a positive area")
+
+// UnsolvableShapeErr means SolveForArea does not know how to resize the shape
+type UnsolvableShapeErr struct {
+	Shape Shape
+}
+
+func (e UnsolvableShapeErr) Error() string {
+	return fmt.Sprintf("cannot solve for the area of %#v", e.Shape)
+}
+
+// SolveForArea returns a version of shape resized to have targetArea. Circles
+// get a new radius and rectangles are scaled uniformly, keeping their aspect ratio
+func SolveForArea(shape Shape, targetArea float64) (Shape, error) {
+	if targetArea < 0 || math.IsNaN(targetArea) {
+		return nil, ErrNegativeArea
+	}
+
+	switch s := shape.(type) {
+	case Circle:
+		return Circle{Radius: math.Sqrt(targetArea / math.Pi)}, nil
+	case Rectangle:
+		area := s.Area()
+		if area <= 0 {
+			if targetArea == 0 {
+				return s, nil
+			}
+			return nil, ErrCannotScale
+		}
+
+		scale := math.Sqrt(targetArea / area)
+		return Rectangle{Width: s.Width * scale, Height: s.Height * scale}, nil
+	}
+
+	return nil, UnsolvableShapeErr{shape}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSolveForArea(t *testing.T) {
+	const tolerance = 1e-9
+
+	solveTests := []struct {
+		name   string
+		shape  Shape
+		target float64
+	}{
+		{name: "grow a circle", shape: Circle{Radius: 1}, target: 100},
+		{name: "shrink a circle", shape: Circle{Radius: 10}, target: 2},
+		{name: "grow a rectangle", shape: Rectangle{Width: 2, Height: 1}, target: 50},
+		{name: "shrink a rectangle", shape: Rectangle{Width: 12, Height: 6}, target: 8},
+	}
+
+	for _, tt := range solveTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SolveForArea(tt.shape, tt.target)
+			if err != nil {
+				t.Fatalf("didn't expect an error, %v", err)
+			}
+
+			if math.Abs(got.Area()-tt.target) > tolerance {
+				t.Errorf("%#v got area %g want %g", got, got.Area(), tt.target)
+			}
+		})
+	}
+
+	t.Run("rectangles keep their aspect ratio", func(t *testing.T) {
+		got, _ := SolveForArea(Rectangle{Width: 2, Height: 1}, 50)
+
+		want := Rectangle{Width: 10, Height: 5}
+		if got != want {
+			t.Errorf("got %#v want %#v", got, want)
+		}
+	})
+
+	t.Run("negative targets are impossible", func(t *testing.T) {
+		_, err := SolveForArea(Circle{Radius: 1}, -1)
+		if err != ErrNegativeArea {
+			t.Errorf("got error %v want %v", err, ErrNegativeArea)
+		}
+	})
+
+	t.Run("a rectangle with no area cannot be scaled", func(t *testing.T) {
+		_, err := SolveForArea(Rectangle{Width: 0, Height: 5}, 10)
+		if err != ErrCannotScale {
+			t.Errorf("got error %v want %v", err, ErrCannotScale)
+		}
+	})
+
+	t.Run("other shapes are not supported", func(t *testing.T) {
+		triangle := Triangle{Base: 12, Height: 6}
+
+		_, err := SolveForArea(triangle, 10)
+		if err != (UnsolvableShapeErr{triangle}) {
+			t.Errorf("got error %v want %v", err, UnsolvableShapeErr{triangle})
+		}
+	})
+}